@@ -0,0 +1,55 @@
+package gmath
+
+import "unsafe"
+
+// Layout of the IEEE 754 binary32 format.
+const (
+	mask32     = 0xFF
+	shift32    = 32 - 8 - 1
+	bias32     = 127
+	signMask32 = 1 << 31
+	fracMask32 = 1<<shift32 - 1
+	uvone32    = 0x3F800000
+
+	// The most significant fraction bit is set for quiet NaNs and clear for
+	// signaling NaNs. The remaining fraction bits are the NaN payload.
+	quietBit32    = 1 << (shift32 - 1)
+	payloadMask32 = quietBit32 - 1
+)
+
+// Layout of the IEEE 754 binary64 format.
+const (
+	mask64        = 0x7FF
+	shift64       = 64 - 11 - 1
+	bias64        = 1023
+	signMask64    = 1 << 63
+	fracMask64    = 1<<shift64 - 1
+	uvinf64       = 0x7FF0000000000000
+	uvneginf64    = 0xFFF0000000000000
+	quietBit64    = 1 << (shift64 - 1)
+	payloadMask64 = quietBit64 - 1
+)
+
+// isFloat reports whether T is a floating point type.
+func isFloat[T Integer | Float]() bool {
+	// Only floating point types can represent 1/2.
+	return T(1)/2 != 0
+}
+
+// is32 reports whether T is a 32-bit floating point type.
+func is32[T Integer | Float]() bool {
+	var x T
+	return isFloat[T]() && unsafe.Sizeof(x) == 4
+}
+
+// magnitude returns the absolute value of the integer x as a uint64. Unlike
+// Abs, the result is always representable, including for the minimum value of
+// a signed integer type.
+func magnitude[T Integer | Float](x T) uint64 {
+	if x < 0 {
+		// Negate in unsigned arithmetic so the minimum value of T doesn't
+		// overflow.
+		return -uint64(x)
+	}
+	return uint64(x)
+}
@@ -0,0 +1,77 @@
+package gmath
+
+// AddChecked returns x+y and whether the sum is representable by T. If the sum
+// overflows, AddChecked returns the wrapped-around result of x+y and false. For
+// example:
+//
+//	AddChecked(int8(100), int8(27)) = 127, true
+//	AddChecked(int8(100), int8(28)) = -128, false
+//	AddChecked(uint8(255), uint8(1)) = 0, false
+func AddChecked[T Integer](x, y T) (T, bool) {
+	s := x + y
+	// Adding a negative y must decrease x and adding a non-negative y must
+	// not, otherwise the sum wrapped around. For unsigned types, y is never
+	// negative, so the sum only overflows if it's less than x.
+	return s, (s < x) == (y < 0)
+}
+
+// SubChecked returns x-y and whether the difference is representable by T. If
+// the difference overflows, SubChecked returns the wrapped-around result of x-y
+// and false. For example:
+//
+//	SubChecked(int8(-100), int8(28)) = -128, true
+//	SubChecked(int8(-100), int8(29)) = 127, false
+//	SubChecked(uint8(0), uint8(1)) = 255, false
+func SubChecked[T Integer](x, y T) (T, bool) {
+	d := x - y
+	// Subtracting a negative y must increase x and subtracting a non-negative
+	// y must not, otherwise the difference wrapped around.
+	return d, (d > x) == (y < 0)
+}
+
+// MulChecked returns x*y and whether the product is representable by T. If the
+// product overflows, MulChecked returns the wrapped-around result of x*y and
+// false. For example:
+//
+//	MulChecked(int8(-64), int8(2)) = -128, true
+//	MulChecked(int8(64), int8(2)) = -128, false
+//	MulChecked(int8(math.MinInt8), int8(-1)) = math.MinInt8, false
+//	MulChecked(uint8(16), uint8(16)) = 0, false
+func MulChecked[T Integer](x, y T) (T, bool) {
+	if x == 0 || y == 0 {
+		return 0, true
+	}
+	p := x * y
+	// Dividing the product by y recovers x unless the product wrapped around.
+	// The exception is MinValue * -1, which wraps around to MinValue, and
+	// MinValue / -1 also wraps around to MinValue. The product of two negative
+	// values is never negative, so check for that case separately.
+	return p, p/y == x && !(x < 0 && y < 0 && p < 0)
+}
+
+// NegChecked returns -x and whether the negation is representable by T. If x
+// is the minimum value of a signed integer type, or a non-zero value of an
+// unsigned integer type, NegChecked returns the wrapped-around result of -x and
+// false. For example:
+//
+//	NegChecked(int8(math.MinInt8)) = math.MinInt8, false
+//	NegChecked(uint8(0)) = 0, true
+//	NegChecked(uint8(1)) = 255, false
+func NegChecked[T Integer](x T) (T, bool) {
+	n := -x
+	// Negating a non-zero value must change its sign. For signed types, that
+	// only fails for the minimum value. For unsigned types, it always fails.
+	return n, x == 0 || (n < 0) != (x < 0)
+}
+
+// Must returns v if ok is true and panics otherwise. It is intended to wrap
+// calls to checked arithmetic functions when overflow is a programming error.
+// For example:
+//
+//	n := gmath.Must(gmath.AddChecked(a, b))
+func Must[T Integer](v T, ok bool) T {
+	if !ok {
+		panic("gmath: integer overflow")
+	}
+	return v
+}
@@ -0,0 +1,1377 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAddChecked(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input  [2]myInt
+			want   myInt
+			wantOK bool
+		}{
+			{
+				input:  [2]myInt{1, 2},
+				want:   3,
+				wantOK: true,
+			},
+			{
+				input:  [2]myInt{math.MaxInt, 1},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int
+			want   int
+			wantOK bool
+		}{
+			{
+				input:  [2]int{math.MaxInt, 0},
+				want:   math.MaxInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MaxInt, 1},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{math.MinInt, -1},
+				want:   math.MaxInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{math.MinInt, math.MaxInt},
+				want:   -1,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MaxInt, math.MaxInt},
+				want:   -2,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{math.MinInt, math.MinInt},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int8
+			want   int8
+			wantOK bool
+		}{
+			{
+				input:  [2]int8{100, 27},
+				want:   127,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{100, 28},
+				want:   -128,
+				wantOK: false,
+			},
+			{
+				input:  [2]int8{-100, -28},
+				want:   -128,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{-100, -29},
+				want:   127,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int16
+			want   int16
+			wantOK bool
+		}{
+			{
+				input:  [2]int16{math.MaxInt16, 1},
+				want:   math.MinInt16,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int32
+			want   int32
+			wantOK bool
+		}{
+			{
+				input:  [2]int32{math.MinInt32, -1},
+				want:   math.MaxInt32,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int64
+			want   int64
+			wantOK bool
+		}{
+			{
+				input:  [2]int64{math.MaxInt64, -1},
+				want:   math.MaxInt64 - 1,
+				wantOK: true,
+			},
+			{
+				input:  [2]int64{math.MaxInt64, 1},
+				want:   math.MinInt64,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint
+			want   uint
+			wantOK bool
+		}{
+			{
+				input:  [2]uint{math.MaxUint, 0},
+				want:   math.MaxUint,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint{math.MaxUint, 1},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint8
+			want   uint8
+			wantOK bool
+		}{
+			{
+				input:  [2]uint8{254, 1},
+				want:   255,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint8{255, 1},
+				want:   0,
+				wantOK: false,
+			},
+			{
+				input:  [2]uint8{255, 255},
+				want:   254,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint16
+			want   uint16
+			wantOK bool
+		}{
+			{
+				input:  [2]uint16{math.MaxUint16, 1},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint32
+			want   uint32
+			wantOK bool
+		}{
+			{
+				input:  [2]uint32{math.MaxUint32, 1},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint64
+			want   uint64
+			wantOK bool
+		}{
+			{
+				input:  [2]uint64{math.MaxUint64, 1},
+				want:   0,
+				wantOK: false,
+			},
+			{
+				input:  [2]uint64{1 << 63, 1 << 62},
+				want:   3 << 62,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uintptr
+			want   uintptr
+			wantOK bool
+		}{
+			{
+				input:  [2]uintptr{0, 1},
+				want:   1,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AddChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
+func TestSubChecked(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input  [2]myInt
+			want   myInt
+			wantOK bool
+		}{
+			{
+				input:  [2]myInt{1, 2},
+				want:   -1,
+				wantOK: true,
+			},
+			{
+				input:  [2]myInt{math.MinInt, 1},
+				want:   math.MaxInt,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int
+			want   int
+			wantOK bool
+		}{
+			{
+				input:  [2]int{math.MinInt, 0},
+				want:   math.MinInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MinInt, 1},
+				want:   math.MaxInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{math.MaxInt, -1},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{-1, math.MaxInt},
+				want:   math.MinInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{0, math.MinInt},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{-1, math.MinInt},
+				want:   math.MaxInt,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int8
+			want   int8
+			wantOK bool
+		}{
+			{
+				input:  [2]int8{-100, 28},
+				want:   -128,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{-100, 29},
+				want:   127,
+				wantOK: false,
+			},
+			{
+				input:  [2]int8{100, -27},
+				want:   127,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{100, -28},
+				want:   -128,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int16
+			want   int16
+			wantOK bool
+		}{
+			{
+				input:  [2]int16{math.MinInt16, 1},
+				want:   math.MaxInt16,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int32
+			want   int32
+			wantOK bool
+		}{
+			{
+				input:  [2]int32{math.MaxInt32, -1},
+				want:   math.MinInt32,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int64
+			want   int64
+			wantOK bool
+		}{
+			{
+				input:  [2]int64{math.MinInt64, -1},
+				want:   math.MinInt64 + 1,
+				wantOK: true,
+			},
+			{
+				input:  [2]int64{math.MinInt64, 1},
+				want:   math.MaxInt64,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint
+			want   uint
+			wantOK bool
+		}{
+			{
+				input:  [2]uint{0, 1},
+				want:   math.MaxUint,
+				wantOK: false,
+			},
+			{
+				input:  [2]uint{1, 1},
+				want:   0,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint8
+			want   uint8
+			wantOK bool
+		}{
+			{
+				input:  [2]uint8{255, 255},
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint8{0, 1},
+				want:   255,
+				wantOK: false,
+			},
+			{
+				input:  [2]uint8{1, 255},
+				want:   2,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint16
+			want   uint16
+			wantOK bool
+		}{
+			{
+				input:  [2]uint16{0, 1},
+				want:   math.MaxUint16,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint32
+			want   uint32
+			wantOK bool
+		}{
+			{
+				input:  [2]uint32{0, 1},
+				want:   math.MaxUint32,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint64
+			want   uint64
+			wantOK bool
+		}{
+			{
+				input:  [2]uint64{0, 1},
+				want:   math.MaxUint64,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uintptr
+			want   uintptr
+			wantOK bool
+		}{
+			{
+				input:  [2]uintptr{1, 0},
+				want:   1,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := SubChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
+func TestMulChecked(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input  [2]myInt
+			want   myInt
+			wantOK bool
+		}{
+			{
+				input:  [2]myInt{-2, 3},
+				want:   -6,
+				wantOK: true,
+			},
+			{
+				input:  [2]myInt{math.MinInt, -1},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int
+			want   int
+			wantOK bool
+		}{
+			{
+				input:  [2]int{math.MaxInt, 0},
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{0, math.MinInt},
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MinInt, 1},
+				want:   math.MinInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MinInt, -1},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{-1, math.MinInt},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{-1, math.MaxInt},
+				want:   -math.MaxInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MaxInt, 2},
+				want:   -2,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{math.MinInt / 2, 2},
+				want:   math.MinInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MinInt / 2, -2},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{1 << 32, 1 << 32},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int8
+			want   int8
+			wantOK bool
+		}{
+			{
+				input:  [2]int8{-64, 2},
+				want:   -128,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{64, 2},
+				want:   -128,
+				wantOK: false,
+			},
+			{
+				input:  [2]int8{-128, -1},
+				want:   -128,
+				wantOK: false,
+			},
+			{
+				input:  [2]int8{-128, 1},
+				want:   -128,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{-1, -128},
+				want:   -128,
+				wantOK: false,
+			},
+			{
+				input:  [2]int8{-16, -8},
+				want:   -128,
+				wantOK: false,
+			},
+			{
+				input:  [2]int8{11, 11},
+				want:   121,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{12, 11},
+				want:   -124,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int16
+			want   int16
+			wantOK bool
+		}{
+			{
+				input:  [2]int16{math.MinInt16, -1},
+				want:   math.MinInt16,
+				wantOK: false,
+			},
+			{
+				input:  [2]int16{181, 181},
+				want:   32761,
+				wantOK: true,
+			},
+			{
+				input:  [2]int16{182, 181},
+				want:   -32594,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int32
+			want   int32
+			wantOK bool
+		}{
+			{
+				input:  [2]int32{math.MinInt32, -1},
+				want:   math.MinInt32,
+				wantOK: false,
+			},
+			{
+				input:  [2]int32{46341, 46341},
+				want:   -2147479015,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int64
+			want   int64
+			wantOK bool
+		}{
+			{
+				input:  [2]int64{math.MinInt64, -1},
+				want:   math.MinInt64,
+				wantOK: false,
+			},
+			{
+				input:  [2]int64{3037000499, 3037000499},
+				want:   9223372030926249001,
+				wantOK: true,
+			},
+			{
+				input:  [2]int64{3037000500, 3037000500},
+				want:   -9223372036709301616,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint
+			want   uint
+			wantOK bool
+		}{
+			{
+				input:  [2]uint{math.MaxUint, 1},
+				want:   math.MaxUint,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint{math.MaxUint, 2},
+				want:   math.MaxUint - 1,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint8
+			want   uint8
+			wantOK bool
+		}{
+			{
+				input:  [2]uint8{15, 17},
+				want:   255,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint8{16, 16},
+				want:   0,
+				wantOK: false,
+			},
+			{
+				input:  [2]uint8{255, 255},
+				want:   1,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint16
+			want   uint16
+			wantOK bool
+		}{
+			{
+				input:  [2]uint16{255, 257},
+				want:   65535,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint16{256, 256},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint32
+			want   uint32
+			wantOK bool
+		}{
+			{
+				input:  [2]uint32{65535, 65537},
+				want:   math.MaxUint32,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint32{65536, 65536},
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uint64
+			want   uint64
+			wantOK bool
+		}{
+			{
+				input:  [2]uint64{1 << 32, 1 << 31},
+				want:   1 << 63,
+				wantOK: true,
+			},
+			{
+				input:  [2]uint64{1 << 32, 1 << 32},
+				want:   0,
+				wantOK: false,
+			},
+			{
+				input:  [2]uint64{math.MaxUint64, math.MaxUint64},
+				want:   1,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input  [2]uintptr
+			want   uintptr
+			wantOK bool
+		}{
+			{
+				input:  [2]uintptr{2, 3},
+				want:   6,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := MulChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
+func TestNegChecked(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input  myInt
+			want   myInt
+			wantOK bool
+		}{
+			{
+				input:  1,
+				want:   -1,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt,
+				want:   math.MinInt,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input  int
+			want   int
+			wantOK bool
+		}{
+			{
+				input:  0,
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  math.MaxInt,
+				want:   -math.MaxInt,
+				wantOK: true,
+			},
+			{
+				input:  -math.MaxInt,
+				want:   math.MaxInt,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt,
+				want:   math.MinInt,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input  int8
+			want   int8
+			wantOK bool
+		}{
+			{
+				input:  -127,
+				want:   127,
+				wantOK: true,
+			},
+			{
+				input:  -128,
+				want:   -128,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input  int16
+			want   int16
+			wantOK bool
+		}{
+			{
+				input:  math.MinInt16,
+				want:   math.MinInt16,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input  int32
+			want   int32
+			wantOK bool
+		}{
+			{
+				input:  math.MinInt32,
+				want:   math.MinInt32,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input  int64
+			want   int64
+			wantOK bool
+		}{
+			{
+				input:  math.MinInt64 + 1,
+				want:   math.MaxInt64,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt64,
+				want:   math.MinInt64,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input  uint
+			want   uint
+			wantOK bool
+		}{
+			{
+				input:  0,
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  1,
+				want:   math.MaxUint,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input  uint8
+			want   uint8
+			wantOK bool
+		}{
+			{
+				input:  0,
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  1,
+				want:   255,
+				wantOK: false,
+			},
+			{
+				input:  128,
+				want:   128,
+				wantOK: false,
+			},
+			{
+				input:  255,
+				want:   1,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input  uint16
+			want   uint16
+			wantOK bool
+		}{
+			{
+				input:  1,
+				want:   math.MaxUint16,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input  uint32
+			want   uint32
+			wantOK bool
+		}{
+			{
+				input:  1,
+				want:   math.MaxUint32,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input  uint64
+			want   uint64
+			wantOK bool
+		}{
+			{
+				input:  0,
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  1 << 63,
+				want:   1 << 63,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input  uintptr
+			want   uintptr
+			wantOK bool
+		}{
+			{
+				input:  0,
+				want:   0,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NegChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
+// TestCheckedExhaustive checks the checked arithmetic functions against
+// arithmetic in a wider type for every int8 and uint8 combination.
+func TestCheckedExhaustive(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			a := int8(x)
+			assertCall(t, checked[int8](-x), result(NegChecked(a)), "NegChecked", x)
+			for y := math.MinInt8; y <= math.MaxInt8; y++ {
+				b := int8(y)
+				assertCall(t, checked[int8](x+y), result(AddChecked(a, b)), "AddChecked", x, y)
+				assertCall(t, checked[int8](x-y), result(SubChecked(a, b)), "SubChecked", x, y)
+				assertCall(t, checked[int8](x*y), result(MulChecked(a, b)), "MulChecked", x, y)
+			}
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		for x := 0; x <= math.MaxUint8; x++ {
+			a := uint8(x)
+			assertCall(t, checked[uint8](-x), result(NegChecked(a)), "NegChecked", x)
+			for y := 0; y <= math.MaxUint8; y++ {
+				b := uint8(y)
+				assertCall(t, checked[uint8](x+y), result(AddChecked(a, b)), "AddChecked", x, y)
+				assertCall(t, checked[uint8](x-y), result(SubChecked(a, b)), "SubChecked", x, y)
+				assertCall(t, checked[uint8](x*y), result(MulChecked(a, b)), "MulChecked", x, y)
+			}
+		}
+	})
+}
+
+// checkedResult holds both results of a checked arithmetic function so that
+// they can be compared in a single assertion.
+type checkedResult[T Integer] struct {
+	v  T
+	ok bool
+}
+
+// result returns the results of a checked arithmetic function as a
+// checkedResult.
+func result[T Integer](v T, ok bool) checkedResult[T] {
+	return checkedResult[T]{v: v, ok: ok}
+}
+
+// checked returns the expected results of a checked arithmetic function whose
+// exact result v is computed in a wider type.
+func checked[T Integer](v int) checkedResult[T] {
+	return result(T(v), v >= int(MinValue[T]()) && v <= int(MaxValue[T]()))
+}
+
+func TestMust(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		got := Must(AddChecked(myInt(1), myInt(2)))
+		assertEqual(t, myInt(3), got)
+	})
+	t.Run("overflow", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("want panic")
+			}
+		}()
+		Must(AddChecked(uint8(255), uint8(1)))
+	})
+}
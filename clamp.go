@@ -0,0 +1,111 @@
+package gmath
+
+import "math"
+
+// Clamp returns x limited to the range [lo, hi]. It is equivalent to
+// MaxNum(lo, MinNum(hi, x)), except that a NaN x is returned unchanged. Because
+// MaxNum and MinNum return the non-NaN operand, a NaN bound is ignored rather
+// than propagated.
+//
+// Special cases are:
+//
+//	Clamp(NaN, lo, hi) = NaN
+//	Clamp(x, NaN, hi) = Min(x, hi)
+//	Clamp(x, lo, NaN) = Max(x, lo)
+//	Clamp(x, lo, hi) = lo if lo > hi
+//
+// Zeros of opposite signs are ordered the same way as by Max and Min, so
+// Clamp(-0, +0, hi) = +0 and Clamp(+0, lo, -0) = -0.
+func Clamp[T Integer | Float](x, lo, hi T) T {
+	if !isFloat[T]() {
+		if x > hi {
+			x = hi
+		}
+		if x < lo {
+			x = lo
+		}
+		return x
+	}
+	if IsNaN(x) {
+		return x
+	}
+	return MaxNum(lo, MinNum(hi, x))
+}
+
+// Wrap returns x wrapped into the periodic range [lo, hi), so that the result
+// differs from x by an integer multiple of hi-lo. For example:
+//
+//	Wrap(-1, 0, 360) = 359
+//	Wrap(540.5, -180, 180) = -179.5
+//	Wrap(-7, 0, 5) = 3
+//
+// For integer types, Wrap is exact for all values of x, lo and hi, including
+// ranges wider than the maximum value of T.
+//
+// Special cases are:
+//
+//	Wrap(x, lo, hi) = lo if hi <= lo
+//	Wrap(NaN, lo, hi) = NaN
+//	Wrap(x, NaN, hi) = Wrap(x, lo, NaN) = NaN
+//	Wrap(±Inf, lo, hi) = NaN
+//	Wrap(x, lo, hi) = NaN if x is outside [lo, hi) and hi-lo is not finite
+//
+// For floating point types, if the wrapped value rounds up to hi, Wrap returns
+// lo, which is the same point in the periodic range.
+func Wrap[T Integer | Float](x, lo, hi T) T {
+	if !isFloat[T]() {
+		if hi <= lo {
+			return lo
+		}
+		// All differences between values of T fit in a uint64, and unsigned
+		// arithmetic wraps around instead of overflowing.
+		w := uint64(hi) - uint64(lo)
+		var off uint64
+		if x >= lo {
+			off = (uint64(x) - uint64(lo)) % w
+		} else if off = (uint64(lo) - uint64(x)) % w; off != 0 {
+			off = w - off
+		}
+		return T(uint64(lo) + off)
+	}
+
+	// special cases
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(lo):
+		return lo
+	case IsNaN(hi):
+		return hi
+	case hi <= lo:
+		return lo
+	case IsInf(x, 0):
+		// Check for infinite x before the range check, because lo can be -Inf.
+		return T(math.NaN())
+	case x >= lo && x < hi:
+		return x
+	}
+	w := float64(hi) - float64(lo)
+	if IsInf(w, 0) {
+		return T(math.NaN())
+	}
+	// Reduce x and lo separately so that x-lo can't overflow.
+	r := floorMod(float64(x), w) - floorMod(float64(lo), w)
+	if r < 0 {
+		r += w
+	}
+	if v := T(float64(lo) + r); v < hi {
+		return v
+	}
+	return lo
+}
+
+// floorMod returns x modulo w with the sign of w. w must be positive and
+// finite.
+func floorMod(x, w float64) float64 {
+	m := math.Mod(x, w)
+	if m < 0 {
+		m += w
+	}
+	return m
+}
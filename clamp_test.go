@@ -0,0 +1,519 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestClamp(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [3]myInt
+			want  myInt
+		}{
+			{
+				input: [3]myInt{7, 0, 5},
+				want:  5,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Clamp(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [3]int
+			want  int
+		}{
+			{
+				input: [3]int{3, 0, 5},
+				want:  3,
+			},
+			{
+				input: [3]int{-1, 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]int{7, 0, 5},
+				want:  5,
+			},
+			{
+				input: [3]int{3, 5, 0},
+				want:  5,
+			},
+			{
+				input: [3]int{math.MinInt, math.MinInt, math.MaxInt},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Clamp(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [3]uint8
+			want  uint8
+		}{
+			{
+				input: [3]uint8{200, 10, 100},
+				want:  100,
+			},
+			{
+				input: [3]uint8{0, 10, 100},
+				want:  10,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Clamp(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [3]float32
+			want  float32
+		}{
+			{
+				input: [3]float32{3, 0, 5},
+				want:  3,
+			},
+			{
+				input: [3]float32{-1, 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]float32{7, 0, 5},
+				want:  5,
+			},
+			{
+				input: [3]float32{3, 5, 0},
+				want:  5,
+			},
+			{
+				input: [3]float32{Inf32(1), 0, 5},
+				want:  5,
+			},
+			{
+				input: [3]float32{Inf32(-1), 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]float32{NaN32(), 0, 5},
+				want:  NaN32(),
+			},
+			{
+				input: [3]float32{-1, NaN32(), 5},
+				want:  -1,
+			},
+			{
+				input: [3]float32{7, NaN32(), 5},
+				want:  5,
+			},
+			{
+				input: [3]float32{7, 0, NaN32()},
+				want:  7,
+			},
+			{
+				input: [3]float32{-1, 0, NaN32()},
+				want:  0,
+			},
+			{
+				input: [3]float32{7, NaN32(), NaN32()},
+				want:  7,
+			},
+			{
+				input: [3]float32{negzero32(), 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]float32{0, -5, negzero32()},
+				want:  negzero32(),
+			},
+			{
+				input: [3]float32{negzero32(), negzero32(), 0},
+				want:  negzero32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Clamp(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [3]float64
+			want  float64
+		}{
+			{
+				input: [3]float64{3, 0, 5},
+				want:  3,
+			},
+			{
+				input: [3]float64{7, 0, 5},
+				want:  5,
+			},
+			{
+				input: [3]float64{3, 5, 0},
+				want:  5,
+			},
+			{
+				input: [3]float64{math.NaN(), 0, 5},
+				want:  math.NaN(),
+			},
+			{
+				input: [3]float64{-1, math.NaN(), 5},
+				want:  -1,
+			},
+			{
+				input: [3]float64{-1, 0, math.NaN()},
+				want:  0,
+			},
+			{
+				input: [3]float64{negzero64(), 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]float64{0, -5, negzero64()},
+				want:  negzero64(),
+			},
+			{
+				input: [3]float64{1, math.Inf(-1), math.Inf(1)},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Clamp(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestWrap(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [3]myInt
+			want  myInt
+		}{
+			{
+				input: [3]myInt{-7, 0, 5},
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Wrap(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [3]int
+			want  int
+		}{
+			{
+				input: [3]int{-7, 0, 5},
+				want:  3,
+			},
+			{
+				input: [3]int{3, 0, 5},
+				want:  3,
+			},
+			{
+				input: [3]int{5, 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]int{-5, 0, 5},
+				want:  0,
+			},
+			{
+				input: [3]int{12, 0, 5},
+				want:  2,
+			},
+			{
+				input: [3]int{-1, -3, 2},
+				want:  -1,
+			},
+			{
+				input: [3]int{-4, -3, 2},
+				want:  1,
+			},
+			{
+				input: [3]int{3, 5, 5},
+				want:  5,
+			},
+			{
+				input: [3]int{3, 5, 0},
+				want:  5,
+			},
+			{
+				input: [3]int{math.MaxInt, math.MinInt, math.MaxInt},
+				want:  math.MinInt,
+			},
+			{
+				input: [3]int{math.MinInt, -1, math.MaxInt},
+				want:  0,
+			},
+			{
+				input: [3]int{math.MaxInt, math.MinInt, 0},
+				want:  -1,
+			},
+			{
+				input: [3]int{-1, 0, math.MaxInt},
+				want:  math.MaxInt - 1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Wrap(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [3]uint8
+			want  uint8
+		}{
+			{
+				input: [3]uint8{250, 10, 20},
+				want:  10,
+			},
+			{
+				input: [3]uint8{5, 10, 20},
+				want:  15,
+			},
+			{
+				input: [3]uint8{255, 0, 255},
+				want:  0,
+			},
+			{
+				input: [3]uint8{3, 254, 255},
+				want:  254,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Wrap(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [3]uint64
+			want  uint64
+		}{
+			{
+				input: [3]uint64{math.MaxUint64, 0, math.MaxUint64},
+				want:  0,
+			},
+			{
+				input: [3]uint64{1, 2, math.MaxUint64},
+				want:  math.MaxUint64 - 1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Wrap(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [3]float32
+			want  float32
+		}{
+			{
+				input: [3]float32{-1, 0, 360},
+				want:  359,
+			},
+			{
+				input: [3]float32{370.5, 0, 360},
+				want:  10.5,
+			},
+			{
+				input: [3]float32{720, 0, 360},
+				want:  0,
+			},
+			{
+				input: [3]float32{-720, 0, 360},
+				want:  0,
+			},
+			{
+				input: [3]float32{180, -180, 180},
+				want:  -180,
+			},
+			{
+				input: [3]float32{-180, -180, 180},
+				want:  -180,
+			},
+			{
+				input: [3]float32{540.5, -180, 180},
+				want:  -179.5,
+			},
+			{
+				input: [3]float32{-1e-30, 0, 360},
+				want:  0,
+			},
+			{
+				input: [3]float32{negzero32(), 0, 360},
+				want:  negzero32(),
+			},
+			{
+				input: [3]float32{1, 0, 0},
+				want:  0,
+			},
+			{
+				input: [3]float32{1, 2, 0},
+				want:  2,
+			},
+			{
+				input: [3]float32{1e30, 0, 3},
+				want:  0,
+			},
+			{
+				input: [3]float32{NaN32(), 0, 1},
+				want:  NaN32(),
+			},
+			{
+				input: [3]float32{1, NaN32(), 1},
+				want:  NaN32(),
+			},
+			{
+				input: [3]float32{1, 0, NaN32()},
+				want:  NaN32(),
+			},
+			{
+				input: [3]float32{Inf32(1), 0, 1},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [3]float32{1, Inf32(-1), 0},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [3]float32{-1, Inf32(-1), 0},
+				want:  -1,
+			},
+			{
+				input: [3]float32{Inf32(-1), Inf32(-1), 0},
+				want:  float32(math.NaN()),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Wrap(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [3]float64
+			want  float64
+		}{
+			{
+				input: [3]float64{-1, 0, 360},
+				want:  359,
+			},
+			{
+				input: [3]float64{370.5, 0, 360},
+				want:  10.5,
+			},
+			{
+				input: [3]float64{-1e-300, 0, 360},
+				want:  0,
+			},
+			{
+				input: [3]float64{-0.25, 0, 0.5},
+				want:  0.25,
+			},
+			{
+				input: [3]float64{1, 0, 0},
+				want:  0,
+			},
+			{
+				input: [3]float64{math.MaxFloat64, -math.MaxFloat64, math.MaxFloat64},
+				want:  math.NaN(),
+			},
+			{
+				input: [3]float64{-3 * math.Pi, -math.Pi, math.Pi},
+				want:  -math.Pi,
+			},
+			{
+				input: [3]float64{7 * math.Pi / 2, -math.Pi, math.Pi},
+				want:  -math.Pi / 2,
+			},
+			{
+				input: [3]float64{math.MaxFloat64, 0, 10},
+				want:  math.Mod(math.MaxFloat64, 10),
+			},
+			{
+				input: [3]float64{math.NaN(), 0, 1},
+				want:  math.NaN(),
+			},
+			{
+				input: [3]float64{math.Inf(-1), 0, 1},
+				want:  math.NaN(),
+			},
+			{
+				input: [3]float64{math.Inf(-1), math.Inf(-1), 0},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Wrap(test.input[0], test.input[1], test.input[2])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+// TestWrapExhaustive checks the integer Wrap against arithmetic in a wider
+// type for every int8 and uint8 combination.
+func TestWrapExhaustive(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			for lo := math.MinInt8; lo <= math.MaxInt8; lo++ {
+				for hi := lo + 1; hi <= math.MaxInt8; hi++ {
+					w := hi - lo
+					want := int8(((x-lo)%w+w)%w + lo)
+					if got := Wrap(int8(x), int8(lo), int8(hi)); got != want {
+						t.Fatalf("Wrap(%d, %d, %d): want %d, got %d", x, lo, hi, want, got)
+					}
+				}
+			}
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		for x := 0; x <= math.MaxUint8; x++ {
+			for lo := 0; lo <= math.MaxUint8; lo++ {
+				for hi := lo + 1; hi <= math.MaxUint8; hi++ {
+					w := hi - lo
+					want := uint8(((x-lo)%w+w)%w + lo)
+					if got := Wrap(uint8(x), uint8(lo), uint8(hi)); got != want {
+						t.Fatalf("Wrap(%d, %d, %d): want %d, got %d", x, lo, hi, want, got)
+					}
+				}
+			}
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package gmath
+
+import (
+	"math"
+	"strconv"
+)
+
+// An FPClass is the IEEE 754 category of a floating point value.
+type FPClass byte
+
+// These constants define the floating point classes returned by Classify.
+const (
+	FPNaN       FPClass = iota // NaN
+	FPInfinite                 // ±Inf
+	FPZero                     // ±0
+	FPSubnormal                // nonzero with less than full precision
+	FPNormal                   // finite and nonzero with full precision
+)
+
+func (c FPClass) String() string {
+	switch c {
+	case FPNaN:
+		return "NaN"
+	case FPInfinite:
+		return "Infinite"
+	case FPZero:
+		return "Zero"
+	case FPSubnormal:
+		return "Subnormal"
+	case FPNormal:
+		return "Normal"
+	}
+	return "FPClass(" + strconv.Itoa(int(c)) + ")"
+}
+
+// Classify returns the IEEE 754 class of x. It inspects the bits of x
+// directly, so float32 values are never converted to float64.
+func Classify[T Float](x T) FPClass {
+	return classOf(x)
+}
+
+// classOf returns the class of the floating point value x. It accepts integer
+// types so that functions over Integer | Float can call it after checking that
+// T is a floating point type.
+func classOf[T Integer | Float](x T) FPClass {
+	if is32[T]() {
+		b := math.Float32bits(float32(x))
+		return classify(uint64(b>>shift32&mask32), uint64(b&fracMask32), mask32)
+	}
+	b := math.Float64bits(float64(x))
+	return classify(b>>shift64&mask64, b&fracMask64, mask64)
+}
+
+// classify returns the class of a floating point value with the given biased
+// exponent and fraction bits. maxExp is the exponent of infinities and NaNs.
+func classify(exp, frac, maxExp uint64) FPClass {
+	switch {
+	case exp == maxExp && frac != 0:
+		return FPNaN
+	case exp == maxExp:
+		return FPInfinite
+	case exp == 0 && frac == 0:
+		return FPZero
+	case exp == 0:
+		return FPSubnormal
+	}
+	return FPNormal
+}
+
+// IsNormal reports whether x is a normal number, meaning it is finite, nonzero
+// and not subnormal. For integer types, IsNormal reports whether x is nonzero.
+func IsNormal[T Integer | Float](x T) bool {
+	if !isFloat[T]() {
+		return x != 0
+	}
+	return classOf(x) == FPNormal
+}
+
+// IsSubnormal reports whether x is a subnormal number, meaning it is nonzero
+// and smaller in magnitude than the smallest normal number of its type. For
+// integer types, IsSubnormal always returns false.
+func IsSubnormal[T Integer | Float](x T) bool {
+	if !isFloat[T]() {
+		return false
+	}
+	return classOf(x) == FPSubnormal
+}
+
+// IsFinite reports whether x is neither an infinity nor a NaN. For integer
+// types, IsFinite always returns true.
+func IsFinite[T Integer | Float](x T) bool {
+	if !isFloat[T]() {
+		return true
+	}
+	c := classOf(x)
+	return c != FPNaN && c != FPInfinite
+}
+
+// Signbit reports whether x is negative or negative zero. For floating point
+// types, Signbit reports the sign bit of x, so it also reports the sign of
+// NaNs. For integer types, Signbit reports whether x < 0.
+func Signbit[T Integer | Float](x T) bool {
+	switch {
+	case !isFloat[T]():
+		return x < 0
+	case is32[T]():
+		return math.Float32bits(float32(x))&signMask32 != 0
+	}
+	return math.Float64bits(float64(x))&signMask64 != 0
+}
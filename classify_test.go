@@ -0,0 +1,455 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestClassify(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  FPClass
+		}{
+			{
+				input: 1,
+				want:  FPNormal,
+			},
+			{
+				input: -math.MaxFloat32,
+				want:  FPNormal,
+			},
+			{
+				input: 0x1p-126,
+				want:  FPNormal,
+			},
+			{
+				input: math.SmallestNonzeroFloat32,
+				want:  FPSubnormal,
+			},
+			{
+				input: -0x1p-127,
+				want:  FPSubnormal,
+			},
+			{
+				input: 0,
+				want:  FPZero,
+			},
+			{
+				input: negzero32(),
+				want:  FPZero,
+			},
+			{
+				input: Inf32(1),
+				want:  FPInfinite,
+			},
+			{
+				input: Inf32(-1),
+				want:  FPInfinite,
+			},
+			{
+				input: NaN32(),
+				want:  FPNaN,
+			},
+			{
+				input: SignalingNaN[float32](1),
+				want:  FPNaN,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Classify(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+			want  FPClass
+		}{
+			{
+				input: 1,
+				want:  FPNormal,
+			},
+			{
+				input: -math.MaxFloat64,
+				want:  FPNormal,
+			},
+			{
+				input: 0x1p-1022,
+				want:  FPNormal,
+			},
+			{
+				input: math.SmallestNonzeroFloat64,
+				want:  FPSubnormal,
+			},
+			{
+				input: -0x1p-1023,
+				want:  FPSubnormal,
+			},
+			{
+				input: 0x1p-127,
+				want:  FPNormal,
+			},
+			{
+				input: 0,
+				want:  FPZero,
+			},
+			{
+				input: negzero64(),
+				want:  FPZero,
+			},
+			{
+				input: math.Inf(1),
+				want:  FPInfinite,
+			},
+			{
+				input: math.Inf(-1),
+				want:  FPInfinite,
+			},
+			{
+				input: math.NaN(),
+				want:  FPNaN,
+			},
+			{
+				input: SignalingNaN[float64](1),
+				want:  FPNaN,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Classify(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestFPClassString(t *testing.T) {
+	tests := []struct {
+		input FPClass
+		want  string
+	}{
+		{
+			input: FPNaN,
+			want:  "NaN",
+		},
+		{
+			input: FPInfinite,
+			want:  "Infinite",
+		},
+		{
+			input: FPZero,
+			want:  "Zero",
+		},
+		{
+			input: FPSubnormal,
+			want:  "Subnormal",
+		},
+		{
+			input: FPNormal,
+			want:  "Normal",
+		},
+		{
+			input: 42,
+			want:  "FPClass(42)",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.want, func(t *testing.T) {
+			got := test.input.String()
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestClassPredicates(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input     myInt
+			normal    bool
+			subnormal bool
+			finite    bool
+			signbit   bool
+		}{
+			{
+				input:     -1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				assertEqual(t, test.normal, IsNormal(test.input))
+				assertEqual(t, test.subnormal, IsSubnormal(test.input))
+				assertEqual(t, test.finite, IsFinite(test.input))
+				assertEqual(t, test.signbit, Signbit(test.input))
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input     int
+			normal    bool
+			subnormal bool
+			finite    bool
+			signbit   bool
+		}{
+			{
+				input:     1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     -1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     0,
+				normal:    false,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     math.MinInt,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				assertEqual(t, test.normal, IsNormal(test.input))
+				assertEqual(t, test.subnormal, IsSubnormal(test.input))
+				assertEqual(t, test.finite, IsFinite(test.input))
+				assertEqual(t, test.signbit, Signbit(test.input))
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input     uint64
+			normal    bool
+			subnormal bool
+			finite    bool
+			signbit   bool
+		}{
+			{
+				input:     1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     0,
+				normal:    false,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     math.MaxUint64,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				assertEqual(t, test.normal, IsNormal(test.input))
+				assertEqual(t, test.subnormal, IsSubnormal(test.input))
+				assertEqual(t, test.finite, IsFinite(test.input))
+				assertEqual(t, test.signbit, Signbit(test.input))
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input     float32
+			normal    bool
+			subnormal bool
+			finite    bool
+			signbit   bool
+		}{
+			{
+				input:     1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     -1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     0x1p-126,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     -0x1p-127,
+				normal:    false,
+				subnormal: true,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     0,
+				normal:    false,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     negzero32(),
+				normal:    false,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     Inf32(1),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   false,
+			},
+			{
+				input:     Inf32(-1),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   true,
+			},
+			{
+				input:     NaNWithPayload[float32](1),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   false,
+			},
+			{
+				input:     NaN32(),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				assertEqual(t, test.normal, IsNormal(test.input))
+				assertEqual(t, test.subnormal, IsSubnormal(test.input))
+				assertEqual(t, test.finite, IsFinite(test.input))
+				assertEqual(t, test.signbit, Signbit(test.input))
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input     float64
+			normal    bool
+			subnormal bool
+			finite    bool
+			signbit   bool
+		}{
+			{
+				input:     1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     -1,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     0x1p-1022,
+				normal:    true,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     -0x1p-1023,
+				normal:    false,
+				subnormal: true,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     0,
+				normal:    false,
+				subnormal: false,
+				finite:    true,
+				signbit:   false,
+			},
+			{
+				input:     negzero64(),
+				normal:    false,
+				subnormal: false,
+				finite:    true,
+				signbit:   true,
+			},
+			{
+				input:     math.Inf(1),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   false,
+			},
+			{
+				input:     math.Inf(-1),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   true,
+			},
+			{
+				input:     math.NaN(),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   false,
+			},
+			{
+				input:     -math.NaN(),
+				normal:    false,
+				subnormal: false,
+				finite:    false,
+				signbit:   true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				assertEqual(t, test.normal, IsNormal(test.input))
+				assertEqual(t, test.subnormal, IsSubnormal(test.input))
+				assertEqual(t, test.finite, IsFinite(test.input))
+				assertEqual(t, test.signbit, Signbit(test.input))
+			})
+		}
+	})
+}
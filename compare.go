@@ -0,0 +1,80 @@
+package gmath
+
+import (
+	"math"
+	"sort"
+)
+
+// Compare returns -1 if a is less than b, 0 if a equals b and +1 if a is
+// greater than b.
+//
+// For floating point types, Compare implements the IEEE 754 totalOrder
+// predicate, which orders every bit pattern:
+//
+//	-qNaN < -sNaN < -Inf < negative finite values < -0 < +0 < positive finite values < +Inf < +sNaN < +qNaN
+//
+// Positive NaNs of the same kind are ordered by increasing payload, and
+// negative NaNs of the same kind are ordered by decreasing payload, so the
+// order of negative NaNs mirrors the order of positive NaNs. Compare only
+// returns 0 if a and b have identical bits, so Compare(-0, +0) = -1 and
+// Compare(NaN, NaN) = 0 for identical NaNs.
+func Compare[T Integer | Float](a, b T) int {
+	if isFloat[T]() {
+		a, b := totalOrderKey(a), totalOrderKey(b)
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Less reports whether a is less than b according to Compare. It is suitable
+// for use with sort.Slice.
+func Less[T Integer | Float](a, b T) bool {
+	return Compare(a, b) < 0
+}
+
+// totalOrderKey returns a signed integer that orders the same way as the IEEE
+// 754 totalOrder predicate for x.
+func totalOrderKey[T Integer | Float](x T) int64 {
+	var k int64
+	if is32[T]() {
+		// Sign extend the float32 bits so the sign bit becomes the sign of k.
+		k = int64(int32(math.Float32bits(float32(x))))
+	} else {
+		k = int64(math.Float64bits(float64(x)))
+	}
+	if k < 0 {
+		// Larger magnitudes of negative values must sort first, so flip all
+		// bits except the sign bit.
+		k ^= math.MaxInt64
+	}
+	return k
+}
+
+// SortFloats sorts xs in increasing order according to Compare. Because
+// Compare orders every bit pattern, the result is deterministic even when xs
+// contains NaNs and zeros of both signs.
+func SortFloats[T Float](xs []T) {
+	sort.Slice(xs, func(i, j int) bool {
+		return Less(xs[i], xs[j])
+	})
+}
+
+// IsSortedFloats reports whether xs is sorted in increasing order according to
+// Compare.
+func IsSortedFloats[T Float](xs []T) bool {
+	return sort.SliceIsSorted(xs, func(i, j int) bool {
+		return Less(xs[i], xs[j])
+	})
+}
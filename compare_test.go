@@ -0,0 +1,360 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  int
+		}{
+			{
+				input: [2]myInt{1, 2},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Compare(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.want < 0, Less(test.input[0], test.input[1]))
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{1, 2},
+				want:  -1,
+			},
+			{
+				input: [2]int{2, 1},
+				want:  1,
+			},
+			{
+				input: [2]int{2, 2},
+				want:  0,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Compare(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.want < 0, Less(test.input[0], test.input[1]))
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  int
+		}{
+			{
+				input: [2]uint64{1, math.MaxUint64},
+				want:  -1,
+			},
+			{
+				input: [2]uint64{math.MaxUint64, 0},
+				want:  1,
+			},
+			{
+				input: [2]uint64{0, 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Compare(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.want < 0, Less(test.input[0], test.input[1]))
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  int
+		}{
+			{
+				input: [2]float32{1, 2},
+				want:  -1,
+			},
+			{
+				input: [2]float32{-2, -1},
+				want:  -1,
+			},
+			{
+				input: [2]float32{-1, -2},
+				want:  1,
+			},
+			{
+				input: [2]float32{-1, 1},
+				want:  -1,
+			},
+			{
+				input: [2]float32{1.5, 1.5},
+				want:  0,
+			},
+			{
+				input: [2]float32{negzero32(), 0},
+				want:  -1,
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  1,
+			},
+			{
+				input: [2]float32{negzero32(), negzero32()},
+				want:  0,
+			},
+			{
+				input: [2]float32{-math.SmallestNonzeroFloat32, negzero32()},
+				want:  -1,
+			},
+			{
+				input: [2]float32{Inf32(-1), -math.MaxFloat32},
+				want:  -1,
+			},
+			{
+				input: [2]float32{math.MaxFloat32, Inf32(1)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{-NaNWithPayload[float32](0), Inf32(-1)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{Inf32(1), NaNWithPayload[float32](0)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{NaN32(), Inf32(-1)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{SignalingNaN[float32](1), NaNWithPayload[float32](0)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), NaNWithPayload[float32](2)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{-NaNWithPayload[float32](2), -NaNWithPayload[float32](1)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), NaNWithPayload[float32](1)},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Compare(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.want < 0, Less(test.input[0], test.input[1]))
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  int
+		}{
+			{
+				input: [2]float64{1, 2},
+				want:  -1,
+			},
+			{
+				input: [2]float64{-2, -1},
+				want:  -1,
+			},
+			{
+				input: [2]float64{-1, 1},
+				want:  -1,
+			},
+			{
+				input: [2]float64{1.5, 1.5},
+				want:  0,
+			},
+			{
+				input: [2]float64{negzero64(), 0},
+				want:  -1,
+			},
+			{
+				input: [2]float64{0, negzero64()},
+				want:  1,
+			},
+			{
+				input: [2]float64{-math.SmallestNonzeroFloat64, negzero64()},
+				want:  -1,
+			},
+			{
+				input: [2]float64{math.Inf(-1), -math.MaxFloat64},
+				want:  -1,
+			},
+			{
+				input: [2]float64{math.MaxFloat64, math.Inf(1)},
+				want:  -1,
+			},
+			{
+				input: [2]float64{-math.NaN(), math.Inf(-1)},
+				want:  -1,
+			},
+			{
+				input: [2]float64{math.Inf(1), math.NaN()},
+				want:  -1,
+			},
+			{
+				input: [2]float64{SignalingNaN[float64](1), NaNWithPayload[float64](0)},
+				want:  -1,
+			},
+			{
+				input: [2]float64{math.NaN(), math.NaN()},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Compare(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.want < 0, Less(test.input[0], test.input[1]))
+			})
+		}
+	})
+}
+
+func TestCompareOrdersFloats(t *testing.T) {
+	// Compare must agree with < for all non-NaN values, other than zeros of
+	// opposite signs.
+	t.Run("float32", func(t *testing.T) {
+		testCompareOrders(t, []float32{
+			Inf32(-1),
+			-math.MaxFloat32,
+			-1e30,
+			-1,
+			-math.SmallestNonzeroFloat32,
+			0,
+			math.SmallestNonzeroFloat32,
+			0x1p-126,
+			1,
+			1e30,
+			math.MaxFloat32,
+			Inf32(1),
+		})
+	})
+	t.Run("float64", func(t *testing.T) {
+		testCompareOrders(t, []float64{
+			math.Inf(-1),
+			-math.MaxFloat64,
+			-1e300,
+			-1,
+			-math.SmallestNonzeroFloat64,
+			0,
+			math.SmallestNonzeroFloat64,
+			0x1p-1022,
+			1,
+			1e300,
+			math.MaxFloat64,
+			math.Inf(1),
+		})
+	})
+}
+
+// testCompareOrders checks that Compare orders every pair of the given values,
+// which must be in strictly increasing order, the same way as their indexes.
+func testCompareOrders[T Float](t *testing.T, values []T) {
+	t.Helper()
+
+	for i, a := range values {
+		for j, b := range values {
+			want := 0
+			switch {
+			case i < j:
+				want = -1
+			case i > j:
+				want = 1
+			}
+			if got := Compare(a, b); got != want {
+				t.Errorf("Compare(%v, %v): want %d, got %d", a, b, want, got)
+			}
+		}
+	}
+}
+
+func TestSortFloats(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		xs := []float32{
+			NaNWithPayload[float32](1),
+			1,
+			negzero32(),
+			Inf32(-1),
+			-NaNWithPayload[float32](1),
+			0,
+			-1,
+			Inf32(1),
+			SignalingNaN[float32](1),
+		}
+		want := []float32{
+			-NaNWithPayload[float32](1),
+			Inf32(-1),
+			-1,
+			negzero32(),
+			0,
+			1,
+			Inf32(1),
+			SignalingNaN[float32](1),
+			NaNWithPayload[float32](1),
+		}
+		assertEqual(t, false, IsSortedFloats(xs))
+		SortFloats(xs)
+		assertEqual(t, true, IsSortedFloats(xs))
+		for i := range want {
+			assertEqual(t, want[i], xs[i])
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		xs := []float64{
+			math.NaN(),
+			0,
+			math.Inf(1),
+			negzero64(),
+			-math.NaN(),
+			-2,
+			0,
+			negzero64(),
+		}
+		want := []float64{
+			-math.NaN(),
+			-2,
+			negzero64(),
+			negzero64(),
+			0,
+			0,
+			math.Inf(1),
+			math.NaN(),
+		}
+		assertEqual(t, false, IsSortedFloats(xs))
+		SortFloats(xs)
+		assertEqual(t, true, IsSortedFloats(xs))
+		for i := range want {
+			assertEqual(t, want[i], xs[i])
+		}
+	})
+	t.Run("empty", func(t *testing.T) {
+		var xs []float64
+		SortFloats(xs)
+		assertEqual(t, true, IsSortedFloats(xs))
+	})
+}
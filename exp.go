@@ -0,0 +1,175 @@
+package gmath
+
+import "math"
+
+// Exp returns e**x, the base-e exponential of x.
+//
+// Special cases are:
+//
+//	Exp(+Inf) = +Inf
+//	Exp(NaN) = NaN
+//
+// Very large values overflow to 0 or +Inf.
+// Very small values underflow to 1.
+func Exp[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Exp(float64(x)))
+}
+
+// Exp2 returns 2**x, the base-2 exponential of x.
+//
+// Special cases are the same as Exp.
+func Exp2[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Exp2(float64(x)))
+}
+
+// Expm1 returns e**x - 1, the base-e exponential of x minus 1.
+// It is more accurate than Exp(x) - 1 when x is near zero.
+//
+// Special cases are:
+//
+//	Expm1(+Inf) = +Inf
+//	Expm1(-Inf) = -1
+//	Expm1(±0) = ±0
+//	Expm1(NaN) = NaN
+//
+// Very large values overflow to -1 or +Inf.
+func Expm1[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Expm1(float64(x)))
+}
+
+// Pow returns x**y, the base-x exponential of y. The result has the type of x.
+//
+// Special cases are (in order):
+//
+//	Pow(x, ±0) = 1 for any x
+//	Pow(1, y) = 1 for any y
+//	Pow(x, 1) = x for any x
+//	Pow(NaN, y) = NaN
+//	Pow(x, NaN) = NaN
+//	Pow(±0, y) = ±Inf for y an odd integer < 0
+//	Pow(±0, -Inf) = +Inf
+//	Pow(±0, +Inf) = +0
+//	Pow(±0, y) = +Inf for finite y < 0 and not an odd integer
+//	Pow(±0, y) = ±0 for y an odd integer > 0
+//	Pow(±0, y) = +0 for finite y > 0 and not an odd integer
+//	Pow(-1, ±Inf) = 1
+//	Pow(x, +Inf) = +Inf for |x| > 1
+//	Pow(x, -Inf) = +0 for |x| > 1
+//	Pow(x, +Inf) = +0 for |x| < 1
+//	Pow(x, -Inf) = +Inf for |x| < 1
+//	Pow(+Inf, y) = +Inf for y > 0
+//	Pow(+Inf, y) = +0 for y < 0
+//	Pow(-Inf, y) = Pow(-0, -y)
+//	Pow(x, y) = NaN for finite x < 0 and finite non-integer y
+//
+// If y is a NaN, it is converted to the type of x. Converting a NaN between
+// float32 and float64 may change its bits.
+//
+// Note that for integer values of y greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because y is converted to a
+// float64.
+func Pow[T0 Float, T1 Integer | Float](x T0, y T1) T0 {
+	// special cases
+	switch {
+	case y == 0 || x == 1:
+		return 1
+	case y == 1:
+		return x
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return T0(y)
+	}
+	return T0(math.Pow(float64(x), float64(y)))
+}
+
+// Pow10 returns 10**n, the base-10 exponential of n, as a T.
+//
+// Special cases are:
+//
+//	Pow10[float32](n) = 0 for n < -45
+//	Pow10[float32](n) = +Inf for n > 38
+//	Pow10[float64](n) = 0 for n < -323
+//	Pow10[float64](n) = +Inf for n > 308
+//
+// For float32, the result is the float32 nearest to 10**n rather than a
+// float64 result rounded again to float32.
+func Pow10[T Float](n int) T {
+	if is32[T]() {
+		switch {
+		case n < -45:
+			return 0
+		case n > 38:
+			return T(Inf32(1))
+		}
+		return T(pow10tab32[n+45])
+	}
+	return T(math.Pow10(n))
+}
+
+// pow10tab32 stores the float32 values 10**n for -45 <= n <= 38.
+var pow10tab32 = [...]float32{
+	1e-45, 1e-44, 1e-43, 1e-42, 1e-41, 1e-40, 1e-39, 1e-38,
+	1e-37, 1e-36, 1e-35, 1e-34, 1e-33, 1e-32, 1e-31, 1e-30,
+	1e-29, 1e-28, 1e-27, 1e-26, 1e-25, 1e-24, 1e-23, 1e-22,
+	1e-21, 1e-20, 1e-19, 1e-18, 1e-17, 1e-16, 1e-15, 1e-14,
+	1e-13, 1e-12, 1e-11, 1e-10, 1e-9, 1e-8, 1e-7, 1e-6,
+	1e-5, 1e-4, 1e-3, 1e-2, 1e-1, 1e0, 1e1, 1e2,
+	1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10,
+	1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18,
+	1e19, 1e20, 1e21, 1e22, 1e23, 1e24, 1e25, 1e26,
+	1e27, 1e28, 1e29, 1e30, 1e31, 1e32, 1e33, 1e34,
+	1e35, 1e36, 1e37, 1e38,
+}
+
+// ExpInt returns e**x, the base-e exponential of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func ExpInt[T Integer](x T) float64 {
+	return math.Exp(float64(x))
+}
+
+// Exp2Int returns 2**x, the base-2 exponential of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func Exp2Int[T Integer](x T) float64 {
+	return math.Exp2(float64(x))
+}
+
+// Expm1Int returns e**x - 1, the base-e exponential of x minus 1.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func Expm1Int[T Integer](x T) float64 {
+	return math.Expm1(float64(x))
+}
+
+// PowInt returns x**y, the base-x exponential of y.
+//
+// Special cases are:
+//
+//	PowInt(x, 0) = 1 for any x
+//	PowInt(1, y) = 1 for any y
+//	PowInt(0, y) = +Inf for y < 0
+//	PowInt(0, y) = 0 for y > 0
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the inputs are
+// converted to float64.
+func PowInt[T0, T1 Integer](x T0, y T1) float64 {
+	return math.Pow(float64(x), float64(y))
+}
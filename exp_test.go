@@ -0,0 +1,573 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestExp(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  1.6487212,
+			},
+			{
+				input: -2,
+				want:  0.13533528,
+			},
+			{
+				input: 1,
+				want:  2.7182817,
+			},
+			{
+				input: 100,
+				want:  Inf32(1),
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: Inf32(-1),
+				want:  0,
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Exp(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: 1000,
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Exp(test.input)
+				got := Exp(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestExp2(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  1.4142135,
+			},
+			{
+				input: -2,
+				want:  0.25,
+			},
+			{
+				input: 100,
+				want:  1.2676506e+30,
+			},
+			{
+				input: 128,
+				want:  Inf32(1),
+			},
+			{
+				input: Inf32(-1),
+				want:  0,
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Exp2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: 1024,
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Exp2(test.input)
+				got := Exp2(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestExpm1(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.6487213,
+			},
+			{
+				input: -2,
+				want:  -0.86466473,
+			},
+			{
+				input: 1e-30,
+				want:  1e-30,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: Inf32(-1),
+				want:  -1,
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Expm1(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: 1e-300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Expm1(test.input)
+				got := Expm1(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestPow(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{2, 0.5},
+				want:  1.4142135,
+			},
+			{
+				input: [2]float32{NaN32(), 0},
+				want:  1,
+			},
+			{
+				input: [2]float32{NaN32(), negzero32()},
+				want:  1,
+			},
+			{
+				input: [2]float32{1, NaN32()},
+				want:  1,
+			},
+			{
+				input: [2]float32{NaN32(), 1},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{NaN32(), 2},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{2, NaN32()},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{0, -3},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{negzero32(), -3},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{0, Inf32(-1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{negzero32(), Inf32(1)},
+				want:  0,
+			},
+			{
+				input: [2]float32{0, -0.5},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{negzero32(), 3},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{negzero32(), 0.5},
+				want:  0,
+			},
+			{
+				input: [2]float32{-1, Inf32(1)},
+				want:  1,
+			},
+			{
+				input: [2]float32{2, Inf32(1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{2, Inf32(-1)},
+				want:  0,
+			},
+			{
+				input: [2]float32{0.5, Inf32(1)},
+				want:  0,
+			},
+			{
+				input: [2]float32{0.5, Inf32(-1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{Inf32(1), 2},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{Inf32(1), -2},
+				want:  0,
+			},
+			{
+				input: [2]float32{Inf32(-1), 3},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{Inf32(-1), -3},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{-2, 0.5},
+				want:  float32(math.Pow(-2, 0.5)),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Pow(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+		}{
+			{
+				input: [2]float64{2, 0.5},
+			},
+			{
+				input: [2]float64{math.NaN(), 0},
+			},
+			{
+				input: [2]float64{1, math.NaN()},
+			},
+			{
+				input: [2]float64{math.NaN(), 2},
+			},
+			{
+				input: [2]float64{0, -3},
+			},
+			{
+				input: [2]float64{negzero64(), -3},
+			},
+			{
+				input: [2]float64{negzero64(), 3},
+			},
+			{
+				input: [2]float64{-1, math.Inf(1)},
+			},
+			{
+				input: [2]float64{0.5, math.Inf(-1)},
+			},
+			{
+				input: [2]float64{math.Inf(-1), -3},
+			},
+			{
+				input: [2]float64{-2, 0.5},
+			},
+			{
+				input: [2]float64{10, 400},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Pow(test.input[0], test.input[1])
+				got := Pow(test.input[0], test.input[1])
+				assertEqual(t, want, got)
+			})
+		}
+	})
+	t.Run("float32, myInt", func(t *testing.T) {
+		tests := []struct {
+			x    float32
+			y    myInt
+			want float32
+		}{
+			{
+				x:    -8,
+				y:    3,
+				want: -512,
+			},
+			{
+				x:    10,
+				y:    -2,
+				want: 0.01,
+			},
+			{
+				x:    negzero32(),
+				y:    -1,
+				want: Inf32(-1),
+			},
+			{
+				x:    NaN32(),
+				y:    0,
+				want: 1,
+			},
+			{
+				x:    NaN32(),
+				y:    2,
+				want: NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.y), func(t *testing.T) {
+				got := Pow(test.x, test.y)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestPow10(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		for n := -50; n <= 50; n++ {
+			t.Run(fmt.Sprint(n), func(t *testing.T) {
+				want := float32(math.Inf(1))
+				if f, err := strconv.ParseFloat(fmt.Sprintf("1e%d", n), 32); err == nil {
+					want = float32(f)
+				}
+				got := Pow10[float32](n)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		for _, n := range []int{-400, -324, -323, -1, 0, 1, 22, 308, 309} {
+			t.Run(fmt.Sprint(n), func(t *testing.T) {
+				want := math.Pow10(n)
+				got := Pow10[float64](n)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestExpInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  2.718281828459045,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ExpInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  float64
+		}{
+			{
+				input: 0,
+				want:  1,
+			},
+			{
+				input: -1,
+				want:  0.36787944117144233,
+			},
+			{
+				input: 1000,
+				want:  math.Inf(1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ExpInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestExp2Int(t *testing.T) {
+	tests := []struct {
+		input uint8
+		want  float64
+	}{
+		{
+			input: 10,
+			want:  1024,
+		},
+		{
+			input: 0,
+			want:  1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := Exp2Int(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestExpm1Int(t *testing.T) {
+	tests := []struct {
+		input int8
+		want  float64
+	}{
+		{
+			input: -1,
+			want:  -0.6321205588285577,
+		},
+		{
+			input: 0,
+			want:  0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := Expm1Int(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestPowInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  float64
+		}{
+			{
+				input: [2]myInt{2, 10},
+				want:  1024,
+			},
+			{
+				input: [2]myInt{0, -1},
+				want:  math.Inf(1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := PowInt(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int, int8", func(t *testing.T) {
+		got := PowInt(-2, int8(-3))
+		assertEqual(t, -0.125, got)
+	})
+}
@@ -0,0 +1,207 @@
+package gmath
+
+import "math"
+
+// Floor returns the greatest integer value less than or equal to x.
+//
+// Special cases are:
+//
+//	Floor(±0) = ±0
+//	Floor(±Inf) = ±Inf
+//	Floor(NaN) = NaN
+//
+// For integer types, Floor returns x.
+func Floor[T Integer | Float](x T) T {
+	switch {
+	case !isFloat[T]():
+		return x
+	case is32[T]():
+		return T(floor32(float32(x)))
+	case IsNaN(x):
+		return x
+	}
+	return T(math.Floor(float64(x)))
+}
+
+// Ceil returns the least integer value greater than or equal to x.
+//
+// Special cases are:
+//
+//	Ceil(±0) = ±0
+//	Ceil(±Inf) = ±Inf
+//	Ceil(NaN) = NaN
+//
+// For integer types, Ceil returns x.
+func Ceil[T Integer | Float](x T) T {
+	switch {
+	case !isFloat[T]():
+		return x
+	case is32[T]():
+		return T(ceil32(float32(x)))
+	case IsNaN(x):
+		return x
+	}
+	return T(math.Ceil(float64(x)))
+}
+
+// Trunc returns the integer value of x.
+//
+// Special cases are:
+//
+//	Trunc(±0) = ±0
+//	Trunc(±Inf) = ±Inf
+//	Trunc(NaN) = NaN
+//
+// For integer types, Trunc returns x.
+func Trunc[T Integer | Float](x T) T {
+	switch {
+	case !isFloat[T]():
+		return x
+	case is32[T]():
+		return T(trunc32(float32(x)))
+	case IsNaN(x):
+		return x
+	}
+	return T(math.Trunc(float64(x)))
+}
+
+// Round returns the nearest integer, rounding half away from zero.
+//
+// Special cases are:
+//
+//	Round(±0) = ±0
+//	Round(±Inf) = ±Inf
+//	Round(NaN) = NaN
+//
+// For integer types, Round returns x.
+func Round[T Integer | Float](x T) T {
+	switch {
+	case !isFloat[T]():
+		return x
+	case is32[T]():
+		return T(round32(float32(x)))
+	case IsNaN(x):
+		return x
+	}
+	return T(math.Round(float64(x)))
+}
+
+// RoundToEven returns the nearest integer, rounding ties to even.
+//
+// Special cases are:
+//
+//	RoundToEven(±0) = ±0
+//	RoundToEven(±Inf) = ±Inf
+//	RoundToEven(NaN) = NaN
+//
+// For integer types, RoundToEven returns x.
+func RoundToEven[T Integer | Float](x T) T {
+	switch {
+	case !isFloat[T]():
+		return x
+	case is32[T]():
+		return T(roundToEven32(float32(x)))
+	case IsNaN(x):
+		return x
+	}
+	return T(math.RoundToEven(float64(x)))
+}
+
+func floor32(x float32) float32 {
+	t := trunc32(x)
+	if x < 0 && t != x {
+		t--
+	}
+	return t
+}
+
+func ceil32(x float32) float32 {
+	t := trunc32(x)
+	if x > 0 && t != x {
+		t++
+	}
+	return t
+}
+
+func trunc32(x float32) float32 {
+	bits := math.Float32bits(x)
+	e := int(bits>>shift32&mask32) - bias32
+	switch {
+	case e < 0:
+		// Truncate abs(x) < 1 including denormals.
+		bits &= signMask32 // +-0
+	case e < shift32:
+		// Clear the fractional bits of any abs(x) >= 1 that has them.
+		bits &^= fracMask32 >> e
+	}
+	// Otherwise x is already an integer, ±Inf or NaN.
+	return math.Float32frombits(bits)
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/floor.go;l=76-110
+
+func round32(x float32) float32 {
+	// Round is a faster implementation of:
+	//
+	// func Round(x float32) float32 {
+	//   t := Trunc(x)
+	//   if Abs(x-t) >= 0.5 {
+	//     return t + Copysign(1, x)
+	//   }
+	//   return t
+	// }
+	bits := math.Float32bits(x)
+	e := uint(bits>>shift32) & mask32
+	if e < bias32 {
+		// Round abs(x) < 1 including denormals.
+		bits &= signMask32 // +-0
+		if e == bias32-1 {
+			bits |= uvone32 // +-1
+		}
+	} else if e < bias32+shift32 {
+		// Round any abs(x) >= 1 containing a fractional component [0,1).
+		//
+		// Numbers with larger exponents are returned unchanged since they
+		// must be either an integer, infinity, or NaN.
+		const half = 1 << (shift32 - 1)
+		e -= bias32
+		bits += half >> e
+		bits &^= fracMask32 >> e
+	}
+	return math.Float32frombits(bits)
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/floor.go;l=112-151
+
+func roundToEven32(x float32) float32 {
+	// RoundToEven is a faster implementation of:
+	//
+	// func RoundToEven(x float32) float32 {
+	//   t := math.Trunc(x)
+	//   odd := math.Remainder(t, 2) != 0
+	//   if d := math.Abs(x - t); d > 0.5 || (d == 0.5 && odd) {
+	//     return t + math.Copysign(1, x)
+	//   }
+	//   return t
+	// }
+	bits := math.Float32bits(x)
+	e := uint(bits>>shift32) & mask32
+	if e >= bias32 {
+		// Round abs(x) >= 1.
+		// - Large numbers without fractional components, infinity, and NaN are
+		//   unchanged.
+		// - Add 0.499.. or 0.5 before truncating depending on whether the
+		//   truncated number is even or odd (respectively).
+		const halfMinusULP = (1 << (shift32 - 1)) - 1
+		e -= bias32
+		bits += (halfMinusULP + (bits>>(shift32-e))&1) >> e
+		bits &^= fracMask32 >> e
+	} else if e == bias32-1 && bits&fracMask32 != 0 {
+		// Round 0.5 < abs(x) < 1.
+		bits = bits&signMask32 | uvone32 // +-1
+	} else {
+		// Round abs(x) <= 0.5 including denormals.
+		bits &= signMask32 // +-0
+	}
+	return math.Float32frombits(bits)
+}
@@ -0,0 +1,812 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFloor(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: -3,
+				want:  -3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Floor(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MaxInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64,
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Floor(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 255,
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Floor(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1.5,
+				want:  1,
+			},
+			{
+				input: -1.5,
+				want:  -2,
+			},
+			{
+				input: -0.5,
+				want:  -1,
+			},
+			{
+				input: 0.5,
+				want:  0,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 1e-45,
+				want:  0,
+			},
+			{
+				input: -1e-45,
+				want:  -1,
+			},
+			{
+				input: 8388607.5,
+				want:  8388607,
+			},
+			{
+				input: 16777216,
+				want:  16777216,
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(-1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+			{
+				input: math.Float32frombits(0x7FA00001),
+				want:  math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Floor(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1.5,
+			},
+			{
+				input: -1.5,
+			},
+			{
+				input: 2.5,
+			},
+			{
+				input: -2.5,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: 0.49999999999999994,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 4503599627370497,
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Floor(test.input)
+				got := Floor(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestCeil(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: -3,
+				want:  -3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Ceil(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MaxInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64,
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Ceil(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 255,
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Ceil(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1.5,
+				want:  2,
+			},
+			{
+				input: -1.5,
+				want:  -1,
+			},
+			{
+				input: -0.5,
+				want:  negzero32(),
+			},
+			{
+				input: 0.5,
+				want:  1,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 1e-45,
+				want:  1,
+			},
+			{
+				input: -8388607.5,
+				want:  -8388607,
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+			{
+				input: math.Float32frombits(0x7FA00001),
+				want:  math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Ceil(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1.5,
+			},
+			{
+				input: -1.5,
+			},
+			{
+				input: 2.5,
+			},
+			{
+				input: -2.5,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: 0.49999999999999994,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 4503599627370497,
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Ceil(test.input)
+				got := Ceil(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestTrunc(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: -3,
+				want:  -3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Trunc(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MaxInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64,
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Trunc(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 255,
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Trunc(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1.5,
+				want:  1,
+			},
+			{
+				input: -1.5,
+				want:  -1,
+			},
+			{
+				input: -0.5,
+				want:  negzero32(),
+			},
+			{
+				input: 0.5,
+				want:  0,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: -8388607.5,
+				want:  -8388607,
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+			{
+				input: math.Float32frombits(0x7FA00001),
+				want:  math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Trunc(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1.5,
+			},
+			{
+				input: -1.5,
+			},
+			{
+				input: 2.5,
+			},
+			{
+				input: -2.5,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: 0.49999999999999994,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 4503599627370497,
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Trunc(test.input)
+				got := Trunc(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestRound(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: -3,
+				want:  -3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Round(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MaxInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64,
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Round(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 255,
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Round(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1.5,
+				want:  2,
+			},
+			{
+				input: -1.5,
+				want:  -2,
+			},
+			{
+				input: 2.5,
+				want:  3,
+			},
+			{
+				input: -0.5,
+				want:  -1,
+			},
+			{
+				input: 0.49999997,
+				want:  0,
+			},
+			{
+				input: -0.49999997,
+				want:  negzero32(),
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 8388607.5,
+				want:  8388608,
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+			{
+				input: math.Float32frombits(0x7FA00001),
+				want:  math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Round(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1.5,
+			},
+			{
+				input: -1.5,
+			},
+			{
+				input: 2.5,
+			},
+			{
+				input: -2.5,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: 0.49999999999999994,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 4503599627370497,
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Round(test.input)
+				got := Round(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestRoundToEven(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: -3,
+				want:  -3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := RoundToEven(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MaxInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64,
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := RoundToEven(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 255,
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := RoundToEven(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1.5,
+				want:  2,
+			},
+			{
+				input: -1.5,
+				want:  -2,
+			},
+			{
+				input: 2.5,
+				want:  2,
+			},
+			{
+				input: -2.5,
+				want:  -2,
+			},
+			{
+				input: 0.5,
+				want:  0,
+			},
+			{
+				input: -0.5,
+				want:  negzero32(),
+			},
+			{
+				input: 0.50000006,
+				want:  1,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 8388606.5,
+				want:  8388606,
+			},
+			{
+				input: 8388607.5,
+				want:  8388608,
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+			{
+				input: math.Float32frombits(0x7FA00001),
+				want:  math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := RoundToEven(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1.5,
+			},
+			{
+				input: -1.5,
+			},
+			{
+				input: 2.5,
+			},
+			{
+				input: -2.5,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: 0.49999999999999994,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 4503599627370497,
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.RoundToEven(test.input)
+				got := RoundToEven(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
@@ -0,0 +1,186 @@
+package gmath
+
+import "math"
+
+// Frexp breaks x into a normalized fraction and an integral power of two. It
+// returns frac and exp satisfying x == frac × 2**exp, with the absolute value
+// of frac in the interval [½, 1).
+//
+// Special cases are:
+//
+//	Frexp(±0) = ±0, 0
+//	Frexp(±Inf) = ±Inf, 0
+//	Frexp(NaN) = NaN, 0
+//
+// For float32 arguments, Frexp operates directly on the float32 bits,
+// including for subnormal values.
+func Frexp[T Float](x T) (frac T, exp int) {
+	if is32[T]() {
+		f, e := frexp32(float32(x))
+		return T(f), e
+	}
+	if IsNaN(x) {
+		return x, 0
+	}
+	f, e := math.Frexp(float64(x))
+	return T(f), e
+}
+
+// Ldexp is the inverse of Frexp.
+// It returns frac × 2**exp.
+//
+// Special cases are:
+//
+//	Ldexp(±0, exp) = ±0
+//	Ldexp(±Inf, exp) = ±Inf
+//	Ldexp(NaN, exp) = NaN
+//
+// For float32 arguments, Ldexp operates directly on the float32 bits and
+// rounds subnormal results once.
+func Ldexp[T Float](frac T, exp int) T {
+	if is32[T]() {
+		return T(ldexp32(float32(frac), exp))
+	}
+	if IsNaN(frac) {
+		return frac
+	}
+	return T(math.Ldexp(float64(frac), exp))
+}
+
+// Scalbn returns x × 2**n, computed by manipulating the exponent of x. For
+// binary floating point types it is equivalent to Ldexp.
+//
+// Special cases are:
+//
+//	Scalbn(±0, n) = ±0
+//	Scalbn(±Inf, n) = ±Inf
+//	Scalbn(NaN, n) = NaN
+func Scalbn[T Float](x T, n int) T {
+	return Ldexp(x, n)
+}
+
+// Modf returns integer and fractional floating-point numbers that sum to x.
+// Both values have the same sign as x.
+//
+// Special cases are:
+//
+//	Modf(±Inf) = ±Inf, NaN
+//	Modf(NaN) = NaN, NaN
+//
+// For float32 arguments, Modf operates directly on the float32 bits.
+func Modf[T Float](x T) (integer T, frac T) {
+	if is32[T]() {
+		i, f := modf32(float32(x))
+		return T(i), T(f)
+	}
+	if IsNaN(x) {
+		return x, x
+	}
+	i, f := math.Modf(float64(x))
+	return T(i), T(f)
+}
+
+// Nextafter returns the next representable value after x towards y.
+//
+// Special cases are:
+//
+//	Nextafter(x, x) = x
+//	Nextafter(NaN, y) = NaN
+//	Nextafter(x, NaN) = NaN
+//
+// For float32 arguments, Nextafter is equivalent to math.Nextafter32, except
+// that a NaN argument is returned unchanged.
+func Nextafter[T Float](x, y T) T {
+	// special cases
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+	if is32[T]() {
+		return T(math.Nextafter32(float32(x), float32(y)))
+	}
+	return T(math.Nextafter(float64(x), float64(y)))
+}
+
+// smallestNormal32 is the smallest positive normal float32, 2**-126.
+const smallestNormal32 = 1.1754943508222875079687365372222456778186655567720875215087517062784172594547271728515625e-38
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/bits.go;l=55-62
+
+// normalize32 returns a normal number y and exponent exp
+// satisfying x == y × 2**exp. It assumes x is finite and non-zero.
+func normalize32(x float32) (y float32, exp int) {
+	if Abs(x) < smallestNormal32 {
+		return x * (1 << 23), -23
+	}
+	return x, 0
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/frexp.go;l=21-38
+
+func frexp32(f float32) (frac float32, exp int) {
+	// special cases
+	switch {
+	case f == 0:
+		return f, 0 // correctly return -0
+	case IsInf(f, 0) || IsNaN(f):
+		return f, 0
+	}
+	f, exp = normalize32(f)
+	x := math.Float32bits(f)
+	exp += int((x>>shift32)&mask32) - bias32 + 1
+	x &^= mask32 << shift32
+	x |= (-1 + bias32) << shift32
+	frac = math.Float32frombits(x)
+	return
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/ldexp.go;l=19-50
+
+func ldexp32(frac float32, exp int) float32 {
+	// special cases
+	switch {
+	case frac == 0:
+		return frac // correctly return -0
+	case IsInf(frac, 0) || IsNaN(frac):
+		return frac
+	}
+	frac, e := normalize32(frac)
+	exp += e
+	x := math.Float32bits(frac)
+	exp += int(x>>shift32)&mask32 - bias32
+	if exp < -150 {
+		return Copysign(float32(0), frac) // underflow
+	}
+	if exp > 127 { // overflow
+		if frac < 0 {
+			return Inf32(-1)
+		}
+		return Inf32(1)
+	}
+	var m float32 = 1
+	if exp < -126 { // denormal
+		exp += 24
+		m = 1.0 / (1 << 24) // 2**-24
+	}
+	x &^= mask32 << shift32
+	x |= uint32(exp+bias32) << shift32
+	return m * math.Float32frombits(x)
+}
+
+func modf32(f float32) (integer float32, frac float32) {
+	// special cases
+	switch {
+	case IsNaN(f):
+		return f, f
+	case IsInf(f, 0):
+		return f, float32(math.NaN())
+	}
+	integer = trunc32(f)
+	// Subtracting the integer part is exact. Copy the sign bit of f so that
+	// negative integral values and -0 get a -0 fractional part.
+	frac = math.Float32frombits(math.Float32bits(f-integer) | math.Float32bits(f)&signMask32)
+	return
+}
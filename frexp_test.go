@@ -0,0 +1,406 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestFrexp(t *testing.T) {
+	type result struct {
+		frac float32
+		exp  int
+	}
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  result
+		}{
+			{
+				input: 8,
+				want:  result{0.5, 4},
+			},
+			{
+				input: -3,
+				want:  result{-0.75, 2},
+			},
+			{
+				input: math.MaxFloat32,
+				want:  result{0.99999994, 128},
+			},
+			{
+				input: math.SmallestNonzeroFloat32,
+				want:  result{0.5, -148},
+			},
+			{
+				input: -math.Float32frombits(0x00400000),
+				want:  result{-0.5, -126},
+			},
+			{
+				input: negzero32(),
+				want:  result{negzero32(), 0},
+			},
+			{
+				input: Inf32(-1),
+				want:  result{Inf32(-1), 0},
+			},
+			{
+				input: NaN32(),
+				want:  result{NaN32(), 0},
+			},
+			{
+				input: math.Float32frombits(0x7FA00001),
+				want:  result{math.Float32frombits(0x7FA00001), 0},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				frac, exp := Frexp(test.input)
+				assertEqual(t, test.want.frac, frac)
+				assertEqual(t, test.want.exp, exp)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 8,
+			},
+			{
+				input: -3,
+			},
+			{
+				input: math.SmallestNonzeroFloat64,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				wantFrac, wantExp := math.Frexp(test.input)
+				frac, exp := Frexp(test.input)
+				assertEqual(t, wantFrac, frac)
+				assertEqual(t, wantExp, exp)
+			})
+		}
+	})
+}
+
+func TestLdexp(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			frac float32
+			exp  int
+			want float32
+		}{
+			{
+				frac: 0.5,
+				exp:  4,
+				want: 8,
+			},
+			{
+				frac: -0.75,
+				exp:  2,
+				want: -3,
+			},
+			{
+				frac: 0.5,
+				exp:  -148,
+				want: math.SmallestNonzeroFloat32,
+			},
+			{
+				frac: 0.75,
+				exp:  -148,
+				want: 2 * math.SmallestNonzeroFloat32,
+			},
+			{
+				frac: 0.5,
+				exp:  -149,
+				want: 0,
+			},
+			{
+				frac: -0.75,
+				exp:  -149,
+				want: -math.SmallestNonzeroFloat32,
+			},
+			{
+				frac: -0.5,
+				exp:  -150,
+				want: negzero32(),
+			},
+			{
+				frac: math.SmallestNonzeroFloat32,
+				exp:  149,
+				want: 1,
+			},
+			{
+				frac: 0.5,
+				exp:  129,
+				want: Inf32(1),
+			},
+			{
+				frac: -0.5,
+				exp:  129,
+				want: Inf32(-1),
+			},
+			{
+				frac: negzero32(),
+				exp:  10,
+				want: negzero32(),
+			},
+			{
+				frac: Inf32(1),
+				exp:  -1000,
+				want: Inf32(1),
+			},
+			{
+				frac: NaN32(),
+				exp:  1,
+				want: NaN32(),
+			},
+			{
+				frac: math.Float32frombits(0x7FA00001),
+				exp:  1,
+				want: math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.frac, test.exp), func(t *testing.T) {
+				got := Ldexp(test.frac, test.exp)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			frac float64
+			exp  int
+		}{
+			{
+				frac: 0.5,
+				exp:  4,
+			},
+			{
+				frac: 0.75,
+				exp:  -1074,
+			},
+			{
+				frac: 0.5,
+				exp:  1025,
+			},
+			{
+				frac: negzero64(),
+				exp:  10,
+			},
+			{
+				frac: math.Inf(-1),
+				exp:  1,
+			},
+			{
+				frac: math.NaN(),
+				exp:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.frac, test.exp), func(t *testing.T) {
+				want := math.Ldexp(test.frac, test.exp)
+				got := Ldexp(test.frac, test.exp)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestScalbn(t *testing.T) {
+	tests := []struct {
+		x    float32
+		n    int
+		want float32
+	}{
+		{
+			x:    3,
+			n:    2,
+			want: 12,
+		},
+		{
+			x:    1,
+			n:    -149,
+			want: math.SmallestNonzeroFloat32,
+		},
+		{
+			x:    NaN32(),
+			n:    2,
+			want: NaN32(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.x, test.n), func(t *testing.T) {
+			got := Scalbn(test.x, test.n)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestModf(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  [2]float32
+		}{
+			{
+				input: 3.25,
+				want:  [2]float32{3, 0.25},
+			},
+			{
+				input: -3.25,
+				want:  [2]float32{-3, -0.25},
+			},
+			{
+				input: -3,
+				want:  [2]float32{-3, negzero32()},
+			},
+			{
+				input: 0.5,
+				want:  [2]float32{0, 0.5},
+			},
+			{
+				input: -math.SmallestNonzeroFloat32,
+				want:  [2]float32{negzero32(), -math.SmallestNonzeroFloat32},
+			},
+			{
+				input: negzero32(),
+				want:  [2]float32{negzero32(), negzero32()},
+			},
+			{
+				input: 16777216,
+				want:  [2]float32{16777216, 0},
+			},
+			{
+				input: Inf32(-1),
+				want:  [2]float32{Inf32(-1), float32(math.NaN())},
+			},
+			{
+				input: NaN32(),
+				want:  [2]float32{NaN32(), NaN32()},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				integer, frac := Modf(test.input)
+				assertEqual(t, test.want[0], integer)
+				assertEqual(t, test.want[1], frac)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 3.25,
+			},
+			{
+				input: -3.25,
+			},
+			{
+				input: -3,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				wantInt, wantFrac := math.Modf(test.input)
+				integer, frac := Modf(test.input)
+				assertEqual(t, wantInt, integer)
+				assertEqual(t, wantFrac, frac)
+			})
+		}
+	})
+}
+
+func TestNextafter(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{1, 2},
+				want:  1.0000001,
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  0.99999994,
+			},
+			{
+				input: [2]float32{0, -1},
+				want:  -math.SmallestNonzeroFloat32,
+			},
+			{
+				input: [2]float32{-math.SmallestNonzeroFloat32, 1},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{math.MaxFloat32, Inf32(1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{negzero32(), 0},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{NaN32(), 1},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{1, math.Float32frombits(0x7FA00001)},
+				want:  math.Float32frombits(0x7FA00001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Nextafter(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+		}{
+			{
+				input: [2]float64{1, 2},
+			},
+			{
+				input: [2]float64{0, -1},
+			},
+			{
+				input: [2]float64{math.MaxFloat64, math.Inf(1)},
+			},
+			{
+				input: [2]float64{negzero64(), 0},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Nextafter(test.input[0], test.input[1])
+				got := Nextafter(test.input[0], test.input[1])
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
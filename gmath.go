@@ -1,12 +1,21 @@
 // Package gmath provides generic versions of some frequently-used Go math
 // package functions.
+//
+// Functions that return a floating point type return NaN arguments unchanged
+// rather than computing a new NaN, so the sign, payload and signaling bit of
+// the NaN are preserved (see Logb and NaNPayload). Functions that always
+// return a float64, like Log and Sin, return float64(x) for a float32 NaN x.
 package gmath
 
-import "math"
+import (
+	"math"
+	"math/bits"
+)
 
 const (
-	// Binary equivalent for a float32 "signaling" NaN.
-	uvsnan32 = 0xFFC00000
+	// Binary equivalent for the float32 NaN returned by NaN32. Note that it is
+	// a quiet NaN with the sign bit set, not a signaling NaN.
+	uvnan32 = 0xFFC00000
 	// Binary equivalent for float32 positive infinity.
 	uvinf32 = 0x7F800000
 	// Binary equivalent for float32 negative infinity.
@@ -24,6 +33,9 @@ const (
 //	Abs(int16(math.MinInt16)) = math.MinInt16
 //	Abs(int32(math.MinInt32)) = math.MinInt32
 //	Abs(int64(math.MinInt64)) = math.MinInt64
+//
+// Use UnsignedAbs, AbsChecked or AbsSat if x may be the minimum value of a
+// signed integer type.
 func Abs[T Signed | Float](x T) T {
 	if IsNaN(x) || x >= 0 {
 		return x
@@ -42,6 +54,8 @@ func Abs[T Signed | Float](x T) T {
 //	Copysign(int16(math.MinInt16), y >= 0) = math.MinInt16
 //	Copysign(int32(math.MinInt32), y >= 0) = math.MinInt32
 //	Copysign(int64(math.MinInt64), y >= 0) = math.MinInt64
+//
+// Use CopysignChecked if x may be the minimum value of a signed integer type.
 func Copysign[T0, T1 Signed | Float](x T0, y T1) T0 {
 	if IsNaN(x) {
 		return x
@@ -58,6 +72,62 @@ func Copysign[T0, T1 Signed | Float](x T0, y T1) T0 {
 	return -x
 }
 
+// UnsignedAbs returns the absolute value of x as the unsigned type U. Unlike
+// Abs, the result is always representable, including for the minimum value of
+// T. For example:
+//
+//	UnsignedAbs[uint8](int8(math.MinInt8)) = 128
+//	UnsignedAbs[uint64](int64(math.MinInt64)) = 9223372036854775808
+//
+// U must be at least as wide as T, usually the unsigned type with the same
+// size as T. UnsignedAbs panics if U is narrower than T, even if the result
+// would fit, because the result can't be representable for every x.
+func UnsignedAbs[U Unsigned, T Signed](x T) U {
+	if BitSize[U]() < BitSize[T]() {
+		panic("gmath: UnsignedAbs result type is narrower than the argument type")
+	}
+	return U(magnitude(x))
+}
+
+// AbsChecked returns the absolute value of x and whether that value is
+// representable by T. If x is the minimum value of a signed integer type,
+// AbsChecked returns x and false.
+//
+// Special cases are:
+//
+//	AbsChecked(±Inf) = +Inf, true
+//	AbsChecked(NaN) = NaN, true
+//	AbsChecked(int(math.MinInt)) = math.MinInt, false
+//	AbsChecked(int8(math.MinInt8)) = math.MinInt8, false
+//	AbsChecked(int16(math.MinInt16)) = math.MinInt16, false
+//	AbsChecked(int32(math.MinInt32)) = math.MinInt32, false
+//	AbsChecked(int64(math.MinInt64)) = math.MinInt64, false
+func AbsChecked[T Signed | Float](x T) (T, bool) {
+	v := Abs(x)
+	// Only the minimum value of a signed integer type stays negative.
+	return v, !(v < 0)
+}
+
+// CopysignChecked returns a value with the magnitude of x and the sign of y,
+// and whether that value is representable by T0. If x is the minimum value of
+// a signed integer type and y is not negative, CopysignChecked returns x and
+// false.
+//
+// Special cases are:
+//
+//	CopysignChecked(NaN, y) = NaN, true
+//	CopysignChecked(x, NaN) = AbsChecked(x)
+//	CopysignChecked(int(math.MinInt), y >= 0) = math.MinInt, false
+//	CopysignChecked(int8(math.MinInt8), y >= 0) = math.MinInt8, false
+//	CopysignChecked(int16(math.MinInt16), y >= 0) = math.MinInt16, false
+//	CopysignChecked(int32(math.MinInt32), y >= 0) = math.MinInt32, false
+//	CopysignChecked(int64(math.MinInt64), y >= 0) = math.MinInt64, false
+func CopysignChecked[T0, T1 Signed | Float](x T0, y T1) (T0, bool) {
+	v := Copysign(x, y)
+	// The result can only have the wrong sign if negating x overflowed.
+	return v, !(v < 0 && !(y < 0))
+}
+
 // From https://cs.opensource.google/go/go/+/go1.17.3:src/math/dim.go;l=13
 
 // Dim returns the maximum of x-y or 0.
@@ -67,12 +137,36 @@ func Copysign[T0, T1 Signed | Float](x T0, y T1) T0 {
 //	Dim(+Inf, +Inf) = NaN
 //	Dim(-Inf, -Inf) = NaN
 //	Dim(x, NaN) = Dim(NaN, x) = NaN
+//
+// For integer types, Dim compares x and y before subtracting, so unsigned
+// values never wrap around. If x-y is greater than the maximum value of T, Dim
+// saturates and returns the maximum value of T. For example:
+//
+//	Dim(uint8(1), uint8(2)) = 0
+//	Dim(int8(100), int8(-100)) = math.MaxInt8
 func Dim[T Integer | Float](x, y T) T {
+	if !isFloat[T]() {
+		if x <= y {
+			return 0
+		}
+		// x > y, so the difference is positive unless the subtraction
+		// overflowed, which is only possible for signed types.
+		if v := x - y; v > 0 {
+			return v
+		}
+		return MaxValue[T]()
+	}
+
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+
 	// The special cases result in NaN after the subtraction:
 	//      +Inf - +Inf = NaN
 	//      -Inf - -Inf = NaN
-	//       NaN - y    = NaN
-	//         x - NaN  = NaN
 	v := x - y
 	if v <= 0 {
 		// v is negative or 0
@@ -86,7 +180,13 @@ func Dim[T Integer | Float](x, y T) T {
 // If sign > 0, IsInf reports whether f is positive infinity.
 // If sign < 0, IsInf reports whether f is negative infinity.
 // If sign == 0, IsInf reports whether f is either infinity.
+//
+// IsInf always returns false for integer types without converting x to a
+// float64.
 func IsInf[T Integer | Float](x T, sign int) bool {
+	if !isFloat[T]() {
+		return false
+	}
 	return math.IsInf(float64(x), sign)
 }
 
@@ -108,20 +208,34 @@ func IsNaN[T Integer | Float](x T) bool {
 //	Log(x < 0) = NaN
 //	Log(NaN) = NaN
 //
-// Note that for integer values greater than 9007199254740993 or less than
-// -9007199254740993, some precision may be lost because the input is converted
-// to a float64.
+// For integer values with a magnitude of at least 2^53, which can't all be
+// represented exactly by a float64, Log computes the result from the exact
+// value of x and is correctly rounded. Smaller integer values are converted to
+// a float64 exactly, so Log has the same accuracy as math.Log for them.
 func Log[T Integer | Float](x T) float64 {
+	if !isFloat[T]() && bits.Len64(magnitude(x)) > 53 {
+		return logInt(x)
+	}
+	if IsNaN(x) {
+		return float64(x)
+	}
 	return math.Log(float64(x))
 }
 
 // Log10 returns the decimal logarithm of x.
 // The special cases are the same as for Log.
 //
-// Note that for integer values greater than 9007199254740993 or less than
-// -9007199254740993, some precision may be lost because the input is converted
-// to a float64.
+// For integer values with a magnitude of at least 2^53, which can't all be
+// represented exactly by a float64, Log10 computes the result from the exact
+// value of x and is correctly rounded. Smaller integer values are converted to
+// a float64 exactly, so Log10 has the same accuracy as math.Log10 for them.
 func Log10[T Integer | Float](x T) float64 {
+	if !isFloat[T]() && bits.Len64(magnitude(x)) > 53 {
+		return log10Int(x)
+	}
+	if IsNaN(x) {
+		return float64(x)
+	}
 	return math.Log10(float64(x))
 }
 
@@ -140,16 +254,26 @@ func Log10[T Integer | Float](x T) float64 {
 // -9007199254740993, some precision may be lost because the input is converted
 // to a float64.
 func Log1p[T Integer | Float](x T) float64 {
+	if IsNaN(x) {
+		return float64(x)
+	}
 	return math.Log1p(float64(x))
 }
 
 // Log2 returns the binary logarithm of x.
 // The special cases are the same as for Log.
 //
-// Note that for integer values greater than 9007199254740993 or less than
-// -9007199254740993, some precision may be lost because the input is converted
-// to a float64.
+// For integer values with a magnitude of at least 2^53, which can't all be
+// represented exactly by a float64, Log2 computes the result from the exact
+// value of x and is correctly rounded. Smaller integer values are converted to
+// a float64 exactly, so Log2 has the same accuracy as math.Log2 for them.
 func Log2[T Integer | Float](x T) float64 {
+	if !isFloat[T]() && bits.Len64(magnitude(x)) > 53 {
+		return log2Int(x)
+	}
+	if IsNaN(x) {
+		return float64(x)
+	}
 	return math.Log2(float64(x))
 }
 
@@ -171,7 +295,7 @@ func Logb[T Float](x T) T {
 		return T(math.Inf(1))
 	case IsNaN(x):
 		// If the input is a NaN, return the original input without converting
-		// it to type T. Float conversion zeros the NaN signal bit, converting
+		// it to type T. Float conversion sets the NaN quiet bit, converting
 		// it from a "signaling NaN" to a "quiet NaN". To preserve the value of
 		// the input NaN, always return it without any conversion.
 		return x
@@ -179,6 +303,19 @@ func Logb[T Float](x T) T {
 	return T(Ilogb(x))
 }
 
+// LogbInt returns the binary exponent of the integer x as a float64. It is
+// the integer equivalent of Logb. The result is exact for all integer values.
+//
+// Special cases are:
+//
+//	LogbInt(0) = -Inf
+func LogbInt[T Integer](x T) float64 {
+	if x == 0 {
+		return math.Inf(-1)
+	}
+	return float64(Ilogb(x))
+}
+
 // Ilogb returns the binary exponent of x as an integer.
 //
 // Special cases are:
@@ -186,7 +323,21 @@ func Logb[T Float](x T) T {
 //	Ilogb(±Inf) = MaxInt32
 //	Ilogb(0) = MinInt32
 //	Ilogb(NaN) = MaxInt32
-func Ilogb[T Float](x T) int {
+//
+// For integer types, Ilogb returns the exact binary exponent of the magnitude
+// of x, computed from its bit length without converting x to a float64. For
+// example:
+//
+//	Ilogb(uint64(math.MaxUint64)) = 63
+//	Ilogb(int8(math.MinInt8)) = 7
+func Ilogb[T Integer | Float](x T) int {
+	if !isFloat[T]() {
+		if x == 0 {
+			return math.MinInt32
+		}
+		return bits.Len64(magnitude(x)) - 1
+	}
+
 	// This conversion from float32 to float64 should be safe. When converting
 	// from float32 to float64, the value and precision of the exponent part of
 	// an IEEE 754 floating point number do not change and the range of exponent
@@ -206,6 +357,20 @@ func Ilogb[T Float](x T) int {
 //	Max(+0, ±0) = Max(±0, +0) = +0
 //	Max(-0, -0) = -0
 func Max[T Integer | Float](x, y T) T {
+	// Integers have no special cases, so skip straight to the comparison. The
+	// float special cases are in a separate function, and isFloat is written
+	// out, so that Max is cheap enough to inline for integer types.
+	if T(1)/2 != 0 {
+		return maxFloat(x, y)
+	}
+	if x > y {
+		return x
+	}
+	return y
+}
+
+// maxFloat returns Max(x, y) for floating point types.
+func maxFloat[T Integer | Float](x, y T) T {
 	// special cases
 	switch {
 	case IsInf(x, 1):
@@ -217,7 +382,7 @@ func Max[T Integer | Float](x, y T) T {
 	case IsNaN(y):
 		return y
 	case x == 0 && x == y:
-		if math.Signbit(float64(x)) {
+		if Signbit(x) {
 			return y
 		}
 		return x
@@ -238,6 +403,20 @@ func Max[T Integer | Float](x, y T) T {
 //	Min(x, NaN) = Min(NaN, x) = NaN
 //	Min(-0, ±0) = Min(±0, -0) = -0
 func Min[T Integer | Float](x, y T) T {
+	// Integers have no special cases, so skip straight to the comparison. The
+	// float special cases are in a separate function, and isFloat is written
+	// out, so that Min is cheap enough to inline for integer types.
+	if T(1)/2 != 0 {
+		return minFloat(x, y)
+	}
+	if x < y {
+		return x
+	}
+	return y
+}
+
+// minFloat returns Min(x, y) for floating point types.
+func minFloat[T Integer | Float](x, y T) T {
 	// special cases
 	switch {
 	case IsInf(x, -1):
@@ -249,7 +428,7 @@ func Min[T Integer | Float](x, y T) T {
 	case IsNaN(y):
 		return y
 	case x == 0 && x == y:
-		if math.Signbit(float64(x)) {
+		if Signbit(x) {
 			return x
 		}
 		return y
@@ -260,19 +439,30 @@ func Min[T Integer | Float](x, y T) T {
 	return y
 }
 
+// Inf returns a positive infinity of type T if sign >= 0, negative infinity if
+// sign < 0. It works for any floating point type, including named types such as
+// "type Celsius float32".
+func Inf[T Float](sign int) T {
+	if is32[T]() {
+		var v uint32
+		if sign >= 0 {
+			v = uvinf32
+		} else {
+			v = uvneginf32
+		}
+		return T(math.Float32frombits(v))
+	}
+	return T(math.Inf(sign))
+}
+
 // Inf32 returns a float32 positive infinity if sign >= 0, negative infinity if
-// sign < 0.
+// sign < 0. It is equivalent to Inf[float32](sign).
 func Inf32(sign int) float32 {
-	var v uint32
-	if sign >= 0 {
-		v = uvinf32
-	} else {
-		v = uvneginf32
-	}
-	return math.Float32frombits(v)
+	return Inf[float32](sign)
 }
 
-// NaN32 returns a float32 IEEE 754 “not-a-number” value.
+// NaN32 returns a float32 IEEE 754 “not-a-number” value. It is equivalent to
+// NaN[float32]().
 func NaN32() float32 {
-	return math.Float32frombits(uvsnan32)
+	return NaN[float32]()
 }
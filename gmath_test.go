@@ -3,6 +3,7 @@ package gmath
 import (
 	"fmt"
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -345,15 +346,755 @@ func TestCopysign(t *testing.T) {
 	})
 }
 
+func TestUnsignedAbs(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  uint
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: 1,
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UnsignedAbs[uint](test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  uint
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: math.MaxInt,
+				want:  math.MaxInt,
+			},
+			{
+				input: math.MinInt,
+				want:  1 << 63,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UnsignedAbs[uint](test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input int8
+			want  uint8
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: math.MaxInt8,
+				want:  math.MaxInt8,
+			},
+			{
+				input: math.MinInt8,
+				want:  128,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UnsignedAbs[uint8](test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input int16
+			want  uint16
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: math.MaxInt16,
+				want:  math.MaxInt16,
+			},
+			{
+				input: math.MinInt16,
+				want:  32768,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UnsignedAbs[uint16](test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input int32
+			want  uint32
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: math.MaxInt32,
+				want:  math.MaxInt32,
+			},
+			{
+				input: math.MinInt32,
+				want:  1 << 31,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UnsignedAbs[uint32](test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  uint64
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: math.MaxInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64,
+				want:  1 << 63,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UnsignedAbs[uint64](test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16, uint64", func(t *testing.T) {
+		got := UnsignedAbs[uint64](int16(math.MinInt16))
+		assertEqual(t, uint64(32768), got)
+	})
+	t.Run("panics for narrower result type", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("want panic")
+			}
+		}()
+		UnsignedAbs[uint8](int64(-1))
+	})
+}
+
+func TestAbsChecked(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input  myInt
+			want   myInt
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input  int
+			want   int
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  math.MaxInt,
+				want:   math.MaxInt,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt,
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  math.MinInt + 1,
+				want:   math.MaxInt,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input  int8
+			want   int8
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt8,
+				want:   math.MinInt8,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input  int16
+			want   int16
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt16,
+				want:   math.MinInt16,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input  int32
+			want   int32
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt32,
+				want:   math.MinInt32,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input  int64
+			want   int64
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  math.MaxInt64,
+				want:   math.MaxInt64,
+				wantOK: true,
+			},
+			{
+				input:  math.MinInt64,
+				want:   math.MinInt64,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input  float32
+			want   float32
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  -math.MaxFloat32,
+				want:   math.MaxFloat32,
+				wantOK: true,
+			},
+			{
+				input:  Inf32(-1),
+				want:   Inf32(1),
+				wantOK: true,
+			},
+			{
+				input:  NaN32(),
+				want:   NaN32(),
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input  float64
+			want   float64
+			wantOK bool
+		}{
+			{
+				input:  -1,
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  -math.MaxFloat64,
+				want:   math.MaxFloat64,
+				wantOK: true,
+			},
+			{
+				input:  math.Inf(-1),
+				want:   math.Inf(1),
+				wantOK: true,
+			},
+			{
+				input:  math.NaN(),
+				want:   math.NaN(),
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := AbsChecked(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
+func TestCopysignChecked(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input  [2]myInt
+			want   myInt
+			wantOK bool
+		}{
+			{
+				input:  [2]myInt{-3, 5},
+				want:   3,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := CopysignChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int
+			want   int
+			wantOK bool
+		}{
+			{
+				input:  [2]int{3, -5},
+				want:   -3,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{-3, 5},
+				want:   3,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MinInt, -5},
+				want:   math.MinInt,
+				wantOK: true,
+			},
+			{
+				input:  [2]int{math.MinInt, 0},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+			{
+				input:  [2]int{math.MinInt, 5},
+				want:   math.MinInt,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := CopysignChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int8
+			want   int8
+			wantOK bool
+		}{
+			{
+				input:  [2]int8{-3, 5},
+				want:   3,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{math.MinInt8, -1},
+				want:   math.MinInt8,
+				wantOK: true,
+			},
+			{
+				input:  [2]int8{math.MinInt8, 1},
+				want:   math.MinInt8,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := CopysignChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]int64
+			want   int64
+			wantOK bool
+		}{
+			{
+				input:  [2]int64{-3, 5},
+				want:   3,
+				wantOK: true,
+			},
+			{
+				input:  [2]int64{math.MinInt64, -1},
+				want:   math.MinInt64,
+				wantOK: true,
+			},
+			{
+				input:  [2]int64{math.MinInt64, 1},
+				want:   math.MinInt64,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := CopysignChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input  [2]float32
+			want   float32
+			wantOK bool
+		}{
+			{
+				input:  [2]float32{-3, 5},
+				want:   3,
+				wantOK: true,
+			},
+			{
+				input:  [2]float32{3, -5},
+				want:   -3,
+				wantOK: true,
+			},
+			{
+				input:  [2]float32{NaN32(), 5},
+				want:   NaN32(),
+				wantOK: true,
+			},
+			{
+				input:  [2]float32{-3, NaN32()},
+				want:   3,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := CopysignChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input  [2]float64
+			want   float64
+			wantOK bool
+		}{
+			{
+				input:  [2]float64{-3, 5},
+				want:   3,
+				wantOK: true,
+			},
+			{
+				input:  [2]float64{3, -5},
+				want:   -3,
+				wantOK: true,
+			},
+			{
+				input:  [2]float64{math.NaN(), 5},
+				want:   math.NaN(),
+				wantOK: true,
+			},
+			{
+				input:  [2]float64{-3, math.NaN()},
+				want:   3,
+				wantOK: true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := CopysignChecked(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
 func TestDim(t *testing.T) {
 	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
-			input [2]myInt
-			want  myInt
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{4, -2},
+				want:  6,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{4, -2},
+				want:  6,
+			},
+			{
+				input: [2]int{-4, 2},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input [2]int64
+			want  int64
+		}{
+			{
+				input: [2]int64{4, -2},
+				want:  6,
+			},
+			{
+				input: [2]int64{-4, 2},
+				want:  0,
+			},
+			{
+				input: [2]int64{math.MaxInt64, math.MinInt64},
+				want:  math.MaxInt64,
+			},
+			{
+				input: [2]int64{math.MaxInt64, -1},
+				want:  math.MaxInt64,
+			},
+			{
+				input: [2]int64{math.MaxInt64, 0},
+				want:  math.MaxInt64,
+			},
+			{
+				input: [2]int64{0, math.MinInt64},
+				want:  math.MaxInt64,
+			},
+			{
+				input: [2]int64{-1, math.MinInt64},
+				want:  math.MaxInt64,
+			},
+			{
+				input: [2]int64{math.MinInt64, math.MaxInt64},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{100, -100},
+				want:  math.MaxInt8,
+			},
+			{
+				input: [2]int8{math.MaxInt8, math.MinInt8},
+				want:  math.MaxInt8,
+			},
+			{
+				input: [2]int8{-1, math.MinInt8},
+				want:  math.MaxInt8,
+			},
+			{
+				input: [2]int8{-100, 27},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint
+			want  uint
+		}{
+			{
+				input: [2]uint{4, 2},
+				want:  2,
+			},
+			{
+				input: [2]uint{2, 4},
+				want:  0,
+			},
+			{
+				input: [2]uint{math.MaxUint, 0},
+				want:  math.MaxUint,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
 		}{
 			{
-				input: [2]myInt{4, -2},
-				want:  6,
+				input: [2]uint8{1, 2},
+				want:  0,
+			},
+			{
+				input: [2]uint8{2, 1},
+				want:  1,
+			},
+			{
+				input: [2]uint8{math.MaxUint8, 0},
+				want:  math.MaxUint8,
+			},
+			{
+				input: [2]uint8{0, math.MaxUint8},
+				want:  0,
 			},
 		}
 		for _, test := range tests {
@@ -363,17 +1104,21 @@ func TestDim(t *testing.T) {
 			})
 		}
 	})
-	t.Run("int", func(t *testing.T) {
+	t.Run("uint16", func(t *testing.T) {
 		tests := []struct {
-			input [2]int
-			want  int
+			input [2]uint16
+			want  uint16
 		}{
 			{
-				input: [2]int{4, -2},
-				want:  6,
+				input: [2]uint16{1, 2},
+				want:  0,
 			},
 			{
-				input: [2]int{-4, 2},
+				input: [2]uint16{math.MaxUint16, 1},
+				want:  math.MaxUint16 - 1,
+			},
+			{
+				input: [2]uint16{0, math.MaxUint16},
 				want:  0,
 			},
 		}
@@ -384,19 +1129,73 @@ func TestDim(t *testing.T) {
 			})
 		}
 	})
-	t.Run("int64", func(t *testing.T) {
+	t.Run("uint32", func(t *testing.T) {
 		tests := []struct {
-			input [2]int64
-			want  int64
+			input [2]uint32
+			want  uint32
 		}{
 			{
-				input: [2]int64{4, -2},
-				want:  6,
+				input: [2]uint32{1, 2},
+				want:  0,
 			},
 			{
-				input: [2]int64{-4, 2},
+				input: [2]uint32{math.MaxUint32, 1},
+				want:  math.MaxUint32 - 1,
+			},
+			{
+				input: [2]uint32{0, math.MaxUint32},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  uint64
+		}{
+			{
+				input: [2]uint64{1, 2},
+				want:  0,
+			},
+			{
+				input: [2]uint64{10, 3},
+				want:  7,
+			},
+			{
+				input: [2]uint64{math.MaxUint64, 0},
+				want:  math.MaxUint64,
+			},
+			{
+				input: [2]uint64{0, math.MaxUint64},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Dim(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input [2]uintptr
+			want  uintptr
+		}{
+			{
+				input: [2]uintptr{1, 2},
 				want:  0,
 			},
+			{
+				input: [2]uintptr{10, 3},
+				want:  7,
+			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
@@ -657,20 +1456,198 @@ func TestIsNaN(t *testing.T) {
 			want  bool
 		}{
 			{
-				input: 1,
-				want:  false,
+				input: 1,
+				want:  false,
+			},
+			{
+				input: NaN32(),
+				want:  true,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := IsNaN(test.input)
+				if test.want != got {
+					t.Errorf("want %v, got %v", test.want, got)
+				}
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.IsNaN(test.input)
+				got := IsNaN(test.input)
+				if want != got {
+					t.Errorf("want %v, got %v", want, got)
+				}
+			})
+		}
+	})
+}
+
+func TestLog(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  2.302585092994046,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Log(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  2.302585092994046,
+			},
+			{
+				input: 0,
+				want:  math.Inf(-1),
+			},
+			{
+				input: -1,
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Log(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  2.302585092994046,
+			},
+			{
+				input: math.MaxInt64,
+				want:  43.66827237527655,
+			},
+			{
+				input: 0,
+				want:  math.Inf(-1),
+			},
+			{
+				input: -1,
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Log(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input uint
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  2.302585092994046,
+			},
+			{
+				input: 0,
+				want:  math.Inf(-1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Log(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input uint64
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  2.302585092994046,
+			},
+			{
+				input: math.MaxUint64,
+				want:  44.3614195558365,
+			},
+			{
+				input: 0,
+				want:  math.Inf(-1),
+			},
+			{
+				input: 779792514258523446,
+				want:  41.197804271847225,
+			},
+			{
+				input: 151047608063142947,
+				want:  39.55637146722006,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Log(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  2.302585092994046,
+			},
+			{
+				input: Inf32(1),
+				want:  math.Inf(1),
+			},
+			{
+				input: 0,
+				want:  math.Inf(-1),
 			},
 			{
 				input: NaN32(),
-				want:  true,
+				want:  float64(NaN32()),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := IsNaN(test.input)
-				if test.want != got {
-					t.Errorf("want %v, got %v", test.want, got)
-				}
+				got := Log(test.input)
+				assertEqual(t, test.want, got)
 			})
 		}
 	})
@@ -679,7 +1656,13 @@ func TestIsNaN(t *testing.T) {
 			input float64
 		}{
 			{
-				input: 1,
+				input: 10,
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: 0,
 			},
 			{
 				input: math.NaN(),
@@ -687,17 +1670,15 @@ func TestIsNaN(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				want := math.IsNaN(test.input)
-				got := IsNaN(test.input)
-				if want != got {
-					t.Errorf("want %v, got %v", want, got)
-				}
+				want := math.Log(test.input)
+				got := Log(test.input)
+				assertEqual(t, want, got)
 			})
 		}
 	})
 }
 
-func TestLog(t *testing.T) {
+func TestLog10(t *testing.T) {
 	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
 			input myInt
@@ -705,12 +1686,12 @@ func TestLog(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.302585092994046,
+				want:  1,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log(test.input)
+				got := Log10(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -722,7 +1703,7 @@ func TestLog(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.302585092994046,
+				want:  1,
 			},
 			{
 				input: 0,
@@ -735,7 +1716,7 @@ func TestLog(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log(test.input)
+				got := Log10(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -747,11 +1728,11 @@ func TestLog(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.302585092994046,
+				want:  1,
 			},
 			{
 				input: math.MaxInt64,
-				want:  43.66827237527655,
+				want:  18.964889726830815,
 			},
 			{
 				input: 0,
@@ -764,7 +1745,7 @@ func TestLog(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log(test.input)
+				got := Log10(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -776,7 +1757,7 @@ func TestLog(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.302585092994046,
+				want:  1,
 			},
 			{
 				input: 0,
@@ -785,7 +1766,7 @@ func TestLog(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log(test.input)
+				got := Log10(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -797,20 +1778,32 @@ func TestLog(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.302585092994046,
+				want:  1,
 			},
 			{
-				input: math.MaxUint64,
-				want:  44.3614195558365,
+				input: math.MaxInt64,
+				want:  18.964889726830815,
 			},
 			{
 				input: 0,
 				want:  math.Inf(-1),
 			},
+			{
+				input: 2788503395973889705,
+				want:  18.445371177732664,
+			},
+			{
+				input: 189952201688534708,
+				want:  17.278644331718798,
+			},
+			{
+				input: 1e19,
+				want:  19,
+			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log(test.input)
+				got := Log10(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -822,7 +1815,7 @@ func TestLog(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.302585092994046,
+				want:  1,
 			},
 			{
 				input: Inf32(1),
@@ -834,12 +1827,12 @@ func TestLog(t *testing.T) {
 			},
 			{
 				input: NaN32(),
-				want:  math.NaN(),
+				want:  float64(NaN32()),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log(test.input)
+				got := Log10(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -863,15 +1856,15 @@ func TestLog(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				want := math.Log(test.input)
-				got := Log(test.input)
+				want := math.Log10(test.input)
+				got := Log10(test.input)
 				assertEqual(t, want, got)
 			})
 		}
 	})
 }
 
-func TestLog10(t *testing.T) {
+func TestLog1p(t *testing.T) {
 	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
 			input myInt
@@ -879,12 +1872,12 @@ func TestLog10(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  1,
+				want:  2.3978952727983707,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log10(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -896,20 +1889,20 @@ func TestLog10(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  1,
+				want:  2.3978952727983707,
 			},
 			{
-				input: 0,
+				input: -1,
 				want:  math.Inf(-1),
 			},
 			{
-				input: -1,
+				input: -2,
 				want:  math.NaN(),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log10(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -921,24 +1914,24 @@ func TestLog10(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  1,
+				want:  2.3978952727983707,
 			},
 			{
-				input: math.MaxInt64,
-				want:  18.964889726830812,
+				input: 0,
+				want:  0,
 			},
 			{
-				input: 0,
+				input: -1,
 				want:  math.Inf(-1),
 			},
 			{
-				input: -1,
+				input: -2,
 				want:  math.NaN(),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log10(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -950,16 +1943,16 @@ func TestLog10(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  1,
+				want:  2.3978952727983707,
 			},
 			{
 				input: 0,
-				want:  math.Inf(-1),
+				want:  0,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log10(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -971,20 +1964,16 @@ func TestLog10(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  1,
-			},
-			{
-				input: math.MaxInt64,
-				want:  18.964889726830812,
+				want:  2.3978952727983707,
 			},
 			{
 				input: 0,
-				want:  math.Inf(-1),
+				want:  0,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log10(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -996,24 +1985,36 @@ func TestLog10(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  1,
+				want:  2.3978952727983707,
 			},
 			{
-				input: Inf32(1),
-				want:  math.Inf(1),
+				input: 0,
+				want:  0,
 			},
 			{
-				input: 0,
+				input: -1,
 				want:  math.Inf(-1),
 			},
 			{
-				input: NaN32(),
+				input: -2,
+				want:  math.NaN(),
+			},
+			{
+				input: Inf32(-1),
 				want:  math.NaN(),
 			},
+			{
+				input: Inf32(1),
+				want:  math.Inf(1),
+			},
+			{
+				input: NaN32(),
+				want:  float64(NaN32()),
+			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log10(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1026,10 +2027,19 @@ func TestLog10(t *testing.T) {
 				input: 10,
 			},
 			{
-				input: math.Inf(1),
+				input: 0,
 			},
 			{
-				input: 0,
+				input: -1,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.Inf(1),
 			},
 			{
 				input: math.NaN(),
@@ -1037,15 +2047,15 @@ func TestLog10(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				want := math.Log10(test.input)
-				got := Log10(test.input)
+				want := math.Log1p(test.input)
+				got := Log1p(test.input)
 				assertEqual(t, want, got)
 			})
 		}
 	})
 }
 
-func TestLog1p(t *testing.T) {
+func TestLog2(t *testing.T) {
 	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
 			input myInt
@@ -1053,12 +2063,12 @@ func TestLog1p(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.3978952727983707,
+				want:  3.321928094887362,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log1p(test.input)
+				got := Log2(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1070,20 +2080,20 @@ func TestLog1p(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.3978952727983707,
+				want:  3.321928094887362,
 			},
 			{
-				input: -1,
+				input: 0,
 				want:  math.Inf(-1),
 			},
 			{
-				input: -2,
+				input: -1,
 				want:  math.NaN(),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log1p(test.input)
+				got := Log2(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1095,24 +2105,32 @@ func TestLog1p(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.3978952727983707,
+				want:  3.321928094887362,
 			},
 			{
 				input: 0,
-				want:  0,
+				want:  math.Inf(-1),
 			},
 			{
 				input: -1,
-				want:  math.Inf(-1),
+				want:  math.NaN(),
 			},
 			{
-				input: -2,
+				input: 1<<53 - 1,
+				want:  math.Log2(1<<53 - 1),
+			},
+			{
+				input: 1 << 53,
+				want:  53,
+			},
+			{
+				input: -1 << 53,
 				want:  math.NaN(),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log1p(test.input)
+				got := Log2(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1124,16 +2142,16 @@ func TestLog1p(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.3978952727983707,
+				want:  3.321928094887362,
 			},
 			{
 				input: 0,
-				want:  0,
+				want:  math.Inf(-1),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log1p(test.input)
+				got := Log2(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1145,16 +2163,28 @@ func TestLog1p(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.3978952727983707,
+				want:  3.321928094887362,
 			},
 			{
 				input: 0,
-				want:  0,
+				want:  math.Inf(-1),
+			},
+			{
+				input: 22065866162535481,
+				want:  54.29266589745679,
+			},
+			{
+				input: 195700537163844362,
+				want:  57.441425329001596,
+			},
+			{
+				input: 1 << 63,
+				want:  63,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log1p(test.input)
+				got := Log2(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1166,36 +2196,89 @@ func TestLog1p(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  2.3978952727983707,
+				want:  3.321928094887362,
 			},
 			{
-				input: 0,
-				want:  0,
+				input: Inf32(1),
+				want:  math.Inf(1),
 			},
 			{
-				input: -1,
+				input: 0,
 				want:  math.Inf(-1),
 			},
 			{
-				input: -2,
-				want:  math.NaN(),
+				input: NaN32(),
+				want:  float64(NaN32()),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Log2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 10,
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: 0,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Log2(test.input)
+				got := Log2(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestLogb(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 10,
+				want:  3,
+			},
+			{
+				input: 9999.0000098765,
+				want:  13,
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
 			},
 			{
 				input: Inf32(-1),
-				want:  math.NaN(),
+				want:  Inf32(1),
 			},
 			{
-				input: Inf32(1),
-				want:  math.Inf(1),
+				input: 0,
+				want:  Inf32(-1),
 			},
 			{
 				input: NaN32(),
-				want:  math.NaN(),
+				want:  NaN32(),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log1p(test.input)
+				got := Logb(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1208,19 +2291,16 @@ func TestLog1p(t *testing.T) {
 				input: 10,
 			},
 			{
-				input: 0,
-			},
-			{
-				input: -1,
+				input: 9999.0000098765,
 			},
 			{
-				input: -2,
+				input: math.Inf(1),
 			},
 			{
 				input: math.Inf(-1),
 			},
 			{
-				input: math.Inf(1),
+				input: 0,
 			},
 			{
 				input: math.NaN(),
@@ -1228,15 +2308,15 @@ func TestLog1p(t *testing.T) {
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				want := math.Log1p(test.input)
-				got := Log1p(test.input)
+				want := math.Logb(test.input)
+				got := Logb(test.input)
 				assertEqual(t, want, got)
 			})
 		}
 	})
 }
 
-func TestLog2(t *testing.T) {
+func TestLogbInt(t *testing.T) {
 	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
 			input myInt
@@ -1244,12 +2324,12 @@ func TestLog2(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  3.321928094887362,
+				want:  3,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log2(test.input)
+				got := LogbInt(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
@@ -1261,219 +2341,214 @@ func TestLog2(t *testing.T) {
 		}{
 			{
 				input: 10,
-				want:  3.321928094887362,
+				want:  3,
 			},
 			{
-				input: 0,
-				want:  math.Inf(-1),
+				input: -10,
+				want:  3,
 			},
 			{
-				input: -1,
-				want:  math.NaN(),
+				input: math.MinInt,
+				want:  63,
+			},
+			{
+				input: 0,
+				want:  math.Inf(-1),
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log2(test.input)
+				got := LogbInt(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
 	})
-	t.Run("int64", func(t *testing.T) {
+	t.Run("uint64", func(t *testing.T) {
 		tests := []struct {
-			input int64
+			input uint64
 			want  float64
 		}{
 			{
-				input: 10,
-				want:  3.321928094887362,
+				input: math.MaxUint64,
+				want:  63,
 			},
 			{
 				input: 0,
 				want:  math.Inf(-1),
 			},
-			{
-				input: -1,
-				want:  math.NaN(),
-			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log2(test.input)
+				got := LogbInt(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
 	})
-	t.Run("uint", func(t *testing.T) {
+}
+
+func TestIlogb(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
-			input uint
-			want  float64
+			input myInt
+			want  int
 		}{
 			{
 				input: 10,
-				want:  3.321928094887362,
-			},
-			{
-				input: 0,
-				want:  math.Inf(-1),
+				want:  3,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log2(test.input)
+				got := Ilogb(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
 	})
-	t.Run("uint64", func(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
 		tests := []struct {
-			input uint64
-			want  float64
+			input int
+			want  int
 		}{
+			{
+				input: 1,
+				want:  0,
+			},
 			{
 				input: 10,
-				want:  3.321928094887362,
+				want:  3,
+			},
+			{
+				input: -10,
+				want:  3,
+			},
+			{
+				input: math.MaxInt,
+				want:  62,
+			},
+			{
+				input: math.MinInt,
+				want:  63,
 			},
 			{
 				input: 0,
-				want:  math.Inf(-1),
+				want:  math.MinInt32,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log2(test.input)
+				got := Ilogb(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
 	})
-	t.Run("float32", func(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
 		tests := []struct {
-			input float32
-			want  float64
+			input int8
+			want  int
 		}{
 			{
-				input: 10,
-				want:  3.321928094887362,
+				input: math.MaxInt8,
+				want:  6,
 			},
 			{
-				input: Inf32(1),
-				want:  math.Inf(1),
+				input: math.MinInt8,
+				want:  7,
 			},
 			{
-				input: 0,
-				want:  math.Inf(-1),
+				input: -1,
+				want:  0,
 			},
 			{
-				input: NaN32(),
-				want:  math.NaN(),
+				input: 0,
+				want:  math.MinInt32,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Log2(test.input)
+				got := Ilogb(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
 	})
-	t.Run("float64", func(t *testing.T) {
+	t.Run("uint8", func(t *testing.T) {
 		tests := []struct {
-			input float64
+			input uint8
+			want  int
 		}{
 			{
-				input: 10,
+				input: 1,
+				want:  0,
 			},
 			{
-				input: math.Inf(1),
+				input: math.MaxUint8,
+				want:  7,
 			},
 			{
 				input: 0,
-			},
-			{
-				input: math.NaN(),
+				want:  math.MinInt32,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				want := math.Log2(test.input)
-				got := Log2(test.input)
-				assertEqual(t, want, got)
+				got := Ilogb(test.input)
+				assertEqual(t, test.want, got)
 			})
 		}
 	})
-}
-
-func TestLogb(t *testing.T) {
-	t.Run("float32", func(t *testing.T) {
+	t.Run("uint64", func(t *testing.T) {
 		tests := []struct {
-			input float32
-			want  float32
+			input uint64
+			want  int
 		}{
 			{
-				input: 10,
-				want:  3,
+				input: 1<<53 + 1,
+				want:  53,
 			},
 			{
-				input: 9999.0000098765,
-				want:  13,
+				input: 1<<63 - 1,
+				want:  62,
 			},
 			{
-				input: Inf32(1),
-				want:  Inf32(1),
+				input: 1 << 63,
+				want:  63,
 			},
 			{
-				input: Inf32(-1),
-				want:  Inf32(1),
+				input: math.MaxUint64,
+				want:  63,
 			},
 			{
 				input: 0,
-				want:  Inf32(-1),
-			},
-			{
-				input: NaN32(),
-				want:  NaN32(),
+				want:  math.MinInt32,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				got := Logb(test.input)
+				got := Ilogb(test.input)
 				assertEqual(t, test.want, got)
 			})
 		}
 	})
-	t.Run("float64", func(t *testing.T) {
+	t.Run("uintptr", func(t *testing.T) {
 		tests := []struct {
-			input float64
+			input uintptr
+			want  int
 		}{
 			{
-				input: 10,
-			},
-			{
-				input: 9999.0000098765,
-			},
-			{
-				input: math.Inf(1),
-			},
-			{
-				input: math.Inf(-1),
+				input: 4096,
+				want:  12,
 			},
 			{
 				input: 0,
-			},
-			{
-				input: math.NaN(),
+				want:  math.MinInt32,
 			},
 		}
 		for _, test := range tests {
 			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
-				want := math.Logb(test.input)
-				got := Logb(test.input)
-				assertEqual(t, want, got)
+				got := Ilogb(test.input)
+				assertEqual(t, test.want, got)
 			})
 		}
 	})
-}
-
-func TestIlogb(t *testing.T) {
 	t.Run("float32", func(t *testing.T) {
 		tests := []struct {
 			input float32
@@ -1537,6 +2612,26 @@ func TestIlogb(t *testing.T) {
 	})
 }
 
+// FuzzIlogb checks that Ilogb returns the same result for integers as for the
+// equivalent floats when the integer is exactly representable as a float64.
+func FuzzIlogb(f *testing.F) {
+	for _, seed := range []int64{0, 1, -1, 10, 1<<53 + 1, math.MaxInt64, math.MinInt64} {
+		f.Add(seed, uint64(seed))
+	}
+	f.Fuzz(func(t *testing.T, i int64, u uint64) {
+		if fi := float64(i); fi >= math.MinInt64 && fi < math.MaxInt64 && int64(fi) == i {
+			if want, got := Ilogb(fi), Ilogb(i); want != got {
+				t.Errorf("Ilogb(int64(%d)): want %d, got %d", i, want, got)
+			}
+		}
+		if fu := float64(u); fu < math.MaxUint64 && uint64(fu) == u {
+			if want, got := Ilogb(fu), Ilogb(u); want != got {
+				t.Errorf("Ilogb(uint64(%d)): want %d, got %d", u, want, got)
+			}
+		}
+	})
+}
+
 func TestMax(t *testing.T) {
 	t.Run("myInt", func(t *testing.T) {
 		tests := []struct {
@@ -2012,6 +3107,63 @@ func assertEqual(t *testing.T, want, got any) {
 	}
 }
 
+func TestInf(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			sign int
+			want uint32
+		}{
+			{
+				sign: 1,
+				want: 0x7F800000,
+			},
+			{
+				sign: 0,
+				want: 0x7F800000,
+			},
+			{
+				sign: -1,
+				want: 0xFF800000,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.sign), func(t *testing.T) {
+				got := Inf[float32](test.sign)
+				assertEqual(t, test.want, math.Float32bits(got))
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			sign int
+		}{
+			{
+				sign: 1,
+			},
+			{
+				sign: 0,
+			},
+			{
+				sign: -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.sign), func(t *testing.T) {
+				want := math.Inf(test.sign)
+				got := Inf[float64](test.sign)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+	t.Run("named types", func(t *testing.T) {
+		type celsius float32
+		assertEqual(t, uint32(0xFF800000), math.Float32bits(float32(Inf[celsius](-1))))
+
+		type kelvin float64
+		assertEqual(t, math.Inf(1), float64(Inf[kelvin](1)))
+	})
+}
+
 func TestInf32(t *testing.T) {
 	pi := Inf32(1)
 	if !math.IsInf(float64(pi), 1) {
@@ -2030,3 +3182,83 @@ func TestNaN32(t *testing.T) {
 		t.Errorf("want NaN, got %v (%0x)", nan, math.Float32bits(nan))
 	}
 }
+
+// benchSink keeps the compiler from optimizing away benchmarked calls.
+var benchSink any
+
+func BenchmarkMax(b *testing.B) {
+	b.Run("int", benchmarkMax[int])
+	b.Run("int64", benchmarkMax[int64])
+	b.Run("uint64", benchmarkMax[uint64])
+	b.Run("float32", benchmarkMax[float32])
+	b.Run("float64", benchmarkMax[float64])
+}
+
+func benchmarkMax[T Integer | Float](b *testing.B) {
+	var got T
+	for i := 0; i < b.N; i++ {
+		got = Max(T(i&0x7F), got)
+	}
+	benchSink = got
+}
+
+func BenchmarkMin(b *testing.B) {
+	b.Run("int", benchmarkMin[int])
+	b.Run("int64", benchmarkMin[int64])
+	b.Run("uint64", benchmarkMin[uint64])
+	b.Run("float32", benchmarkMin[float32])
+	b.Run("float64", benchmarkMin[float64])
+}
+
+func benchmarkMin[T Integer | Float](b *testing.B) {
+	got := T(0x7F)
+	for i := 0; i < b.N; i++ {
+		got = Min(T(i&0x7F), got)
+	}
+	benchSink = got
+}
+
+func BenchmarkIsInf(b *testing.B) {
+	b.Run("int", benchmarkIsInf[int])
+	b.Run("int64", benchmarkIsInf[int64])
+	b.Run("uint64", benchmarkIsInf[uint64])
+	b.Run("float32", benchmarkIsInf[float32])
+	b.Run("float64", benchmarkIsInf[float64])
+}
+
+func benchmarkIsInf[T Integer | Float](b *testing.B) {
+	var got bool
+	for i := 0; i < b.N; i++ {
+		got = IsInf(T(i&0x7F), 0) != got
+	}
+	benchSink = got
+}
+
+func BenchmarkIsNaN(b *testing.B) {
+	b.Run("int", benchmarkIsNaN[int])
+	b.Run("int64", benchmarkIsNaN[int64])
+	b.Run("uint64", benchmarkIsNaN[uint64])
+	b.Run("float32", benchmarkIsNaN[float32])
+	b.Run("float64", benchmarkIsNaN[float64])
+}
+
+func benchmarkIsNaN[T Integer | Float](b *testing.B) {
+	var got bool
+	for i := 0; i < b.N; i++ {
+		got = IsNaN(T(i&0x7F)) != got
+	}
+	benchSink = got
+}
+
+// assertCall fails the test immediately if got is not equal to want, reporting
+// the call name(args...) that returned got. Exhaustive tests use it so that
+// they stop at the first failure instead of reporting every combination of
+// arguments.
+func assertCall[T comparable](t *testing.T, want, got T, name string, args ...int) {
+	t.Helper()
+
+	if want != got {
+		s := strings.Trim(strings.ReplaceAll(fmt.Sprint(args), " ", ", "), "[]")
+		t.Fatalf("%s(%s): want %v, got %v", name, s, want, got)
+	}
+}
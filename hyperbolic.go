@@ -0,0 +1,165 @@
+package gmath
+
+import "math"
+
+// Sinh returns the hyperbolic sine of x.
+//
+// Special cases are:
+//
+//	Sinh(±0) = ±0
+//	Sinh(±Inf) = ±Inf
+//	Sinh(NaN) = NaN
+//
+// Use SinhInt for integer values.
+func Sinh[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Sinh(float64(x)))
+}
+
+// Cosh returns the hyperbolic cosine of x.
+//
+// Special cases are:
+//
+//	Cosh(±0) = 1
+//	Cosh(±Inf) = +Inf
+//	Cosh(NaN) = NaN
+//
+// Use CoshInt for integer values.
+func Cosh[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Cosh(float64(x)))
+}
+
+// Tanh returns the hyperbolic tangent of x.
+//
+// Special cases are:
+//
+//	Tanh(±0) = ±0
+//	Tanh(±Inf) = ±1
+//	Tanh(NaN) = NaN
+//
+// Use TanhInt for integer values.
+func Tanh[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Tanh(float64(x)))
+}
+
+// Asinh returns the inverse hyperbolic sine of x.
+// It is accurate for x near zero, where Asinh(x) ≈ x.
+//
+// Special cases are:
+//
+//	Asinh(±0) = ±0
+//	Asinh(±Inf) = ±Inf
+//	Asinh(NaN) = NaN
+//
+// Use AsinhInt for integer values.
+func Asinh[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Asinh(float64(x)))
+}
+
+// Acosh returns the inverse hyperbolic cosine of x.
+//
+// Special cases are:
+//
+//	Acosh(+Inf) = +Inf
+//	Acosh(x < 1) = NaN
+//	Acosh(NaN) = NaN
+//
+// Use AcoshInt for integer values.
+func Acosh[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Acosh(float64(x)))
+}
+
+// Atanh returns the inverse hyperbolic tangent of x.
+// It is accurate for x near zero, where Atanh(x) ≈ x.
+//
+// Special cases are:
+//
+//	Atanh(1) = +Inf
+//	Atanh(±0) = ±0
+//	Atanh(-1) = -Inf
+//	Atanh(x > 1) = NaN
+//	Atanh(x < -1) = NaN
+//	Atanh(NaN) = NaN
+//
+// Use AtanhInt for integer values.
+func Atanh[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Atanh(float64(x)))
+}
+
+// SinhInt returns the hyperbolic sine of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func SinhInt[T Integer](x T) float64 {
+	return math.Sinh(float64(x))
+}
+
+// CoshInt returns the hyperbolic cosine of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func CoshInt[T Integer](x T) float64 {
+	return math.Cosh(float64(x))
+}
+
+// TanhInt returns the hyperbolic tangent of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func TanhInt[T Integer](x T) float64 {
+	return math.Tanh(float64(x))
+}
+
+// AsinhInt returns the inverse hyperbolic sine of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func AsinhInt[T Integer](x T) float64 {
+	return math.Asinh(float64(x))
+}
+
+// AcoshInt returns the inverse hyperbolic cosine of x.
+//
+// Special cases are:
+//
+//	AcoshInt(x < 1) = NaN
+//
+// Note that for integer values greater than 9007199254740993, some precision
+// may be lost because the input is converted to a float64.
+func AcoshInt[T Integer](x T) float64 {
+	return math.Acosh(float64(x))
+}
+
+// AtanhInt returns the inverse hyperbolic tangent of x.
+//
+// Special cases are:
+//
+//	AtanhInt(1) = +Inf
+//	AtanhInt(0) = 0
+//	AtanhInt(-1) = -Inf
+//	AtanhInt(x > 1) = NaN
+//	AtanhInt(x < -1) = NaN
+func AtanhInt[T Integer](x T) float64 {
+	return math.Atanh(float64(x))
+}
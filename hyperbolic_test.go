@@ -0,0 +1,692 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSinh(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.5210953,
+			},
+			{
+				input: -2,
+				want:  -3.6268604,
+			},
+			{
+				input: 1e-30,
+				want:  1e-30,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 100,
+				want:  Inf32(1),
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(-1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Sinh(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: 1e-300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Sinh(test.input)
+				got := Sinh(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestCosh(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  1.127626,
+			},
+			{
+				input: -2,
+				want:  3.7621956,
+			},
+			{
+				input: negzero32(),
+				want:  1,
+			},
+			{
+				input: 100,
+				want:  Inf32(1),
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Cosh(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Cosh(test.input)
+				got := Cosh(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestTanh(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.46211717,
+			},
+			{
+				input: -2,
+				want:  -0.9640276,
+			},
+			{
+				input: 1e-30,
+				want:  1e-30,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 100,
+				want:  1,
+			},
+			{
+				input: Inf32(-1),
+				want:  -1,
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Tanh(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: 1e-300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Tanh(test.input)
+				got := Tanh(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestAsinh(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.4812118,
+			},
+			{
+				input: -2,
+				want:  -1.4436355,
+			},
+			{
+				input: 1e-30,
+				want:  1e-30,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(-1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Asinh(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: 1e-300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Asinh(test.input)
+				got := Asinh(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestAcosh(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: 2,
+				want:  1.316958,
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: 0.5,
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Acosh(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 1,
+			},
+			{
+				input: 2,
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Acosh(test.input)
+				got := Acosh(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestAtanh(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.54930615,
+			},
+			{
+				input: 1e-30,
+				want:  1e-30,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 1,
+				want:  Inf32(1),
+			},
+			{
+				input: -1,
+				want:  Inf32(-1),
+			},
+			{
+				input: 2,
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Atanh(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: 1e-300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 1,
+			},
+			{
+				input: -1,
+			},
+			{
+				input: 2,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Atanh(test.input)
+				got := Atanh(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestSinhInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  1.1752011936438014,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SinhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  float64
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: -2,
+				want:  -3.626860407847019,
+			},
+			{
+				input: 1000,
+				want:  math.Inf(1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SinhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestCoshInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  1.5430806348152437,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := CoshInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input int8
+			want  float64
+		}{
+			{
+				input: 0,
+				want:  1,
+			},
+			{
+				input: -2,
+				want:  3.7621956910836314,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := CoshInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestTanhInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  0.7615941559557649,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := TanhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input int32
+			want  float64
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: -20,
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := TanhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestAsinhInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  0.881373587019543,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AsinhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  float64
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: -2,
+				want:  -1.4436354751788103,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AsinhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestAcoshInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 2,
+				want:  1.3169578969248166,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AcoshInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input uint
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: 0,
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AcoshInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestAtanhInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AtanhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  math.Inf(1),
+			},
+			{
+				input: -1,
+				want:  math.Inf(-1),
+			},
+			{
+				input: 2,
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AtanhInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package gmath
+
+import (
+	"math"
+	"math/bits"
+)
+
+// pow10tab64 contains the powers of 10 representable by a uint64.
+var pow10tab64 = [...]uint64{
+	1e00, 1e01, 1e02, 1e03, 1e04, 1e05, 1e06, 1e07, 1e08, 1e09,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// ILog2 returns the binary logarithm of x rounded down to an integer. Unlike
+// Log2, the result is exact for all integer values.
+//
+// Special cases are:
+//
+//	ILog2(x <= 0) = MinInt32
+func ILog2[T Integer](x T) int {
+	if x <= 0 {
+		return math.MinInt32
+	}
+	return bits.Len64(uint64(x)) - 1
+}
+
+// ILog10 returns the decimal logarithm of x rounded down to an integer. Unlike
+// Log10, the result is exact for all integer values.
+//
+// Special cases are:
+//
+//	ILog10(x <= 0) = MinInt32
+func ILog10[T Integer](x T) int {
+	if x <= 0 {
+		return math.MinInt32
+	}
+	u := uint64(x)
+	// 1233/4096 is slightly larger than log10(2), so n is either the decimal
+	// logarithm of x or one more than it.
+	n := bits.Len64(u) * 1233 >> 12
+	if u < pow10tab64[n] {
+		n--
+	}
+	return n
+}
+
+// ILogBase returns the base b logarithm of x rounded down to an integer. The
+// result is exact for all integer values.
+//
+// Special cases are:
+//
+//	ILogBase(x <= 0, b) = MinInt32
+//
+// ILogBase panics if b is less than 2.
+func ILogBase[T Integer](x T, b int) int {
+	if b < 2 {
+		panic("gmath: ILogBase base must be at least 2")
+	}
+	switch b {
+	case 2:
+		return ILog2(x)
+	case 10:
+		return ILog10(x)
+	}
+	if x <= 0 {
+		return math.MinInt32
+	}
+	u, base := uint64(x), uint64(b)
+	n := 0
+	for u >= base {
+		u /= base
+		n++
+	}
+	return n
+}
+
+// The integer logarithm functions below evaluate the logarithm in
+// double-double arithmetic, which carries about 106 bits of precision. That
+// accounts for all 64 bits of the input and leaves enough extra precision to
+// round the result correctly to a float64.
+
+// dd is a double-double value equal to hi+lo, where |lo| is at most half an ULP
+// of hi.
+type dd struct {
+	hi, lo float64
+}
+
+var (
+	ddLn2  = dd{0.6931471805599453, 2.3190468138462996e-17}
+	ddLn10 = dd{2.302585092994046, -2.1707562233822494e-16}
+)
+
+// ddInvOdd contains the reciprocals 1/(2i+1) used by the series in logUint64.
+var ddInvOdd = func() (t [22]dd) {
+	for i := range t {
+		t[i] = ddDiv(dd{hi: 1}, dd{hi: float64(2*i + 1)})
+	}
+	return t
+}()
+
+// twoSum returns a+b and the rounding error of that sum.
+func twoSum(a, b float64) (s, e float64) {
+	s = a + b
+	bb := s - a
+	e = (a - (s - bb)) + (b - bb)
+	return s, e
+}
+
+// quickTwoSum returns a+b as a double-double. |a| must be at least |b|.
+func quickTwoSum(a, b float64) dd {
+	s := a + b
+	return dd{s, b - (s - a)}
+}
+
+// ddAdd returns x+y.
+func ddAdd(x, y dd) dd {
+	s, e := twoSum(x.hi, y.hi)
+	t, f := twoSum(x.lo, y.lo)
+	r := quickTwoSum(s, e+t)
+	return quickTwoSum(r.hi, r.lo+f)
+}
+
+// ddMul returns x*y.
+func ddMul(x, y dd) dd {
+	p := x.hi * y.hi
+	e := math.FMA(x.hi, y.hi, -p)
+	return quickTwoSum(p, e+(x.hi*y.lo+x.lo*y.hi))
+}
+
+// ddDiv returns x/y.
+func ddDiv(x, y dd) dd {
+	q1 := x.hi / y.hi
+	r := ddAdd(x, ddMul(dd{hi: -q1}, y))
+	q2 := r.hi / y.hi
+	r = ddAdd(r, ddMul(dd{hi: -q2}, y))
+	q3 := r.hi / y.hi
+	return ddAdd(quickTwoSum(q1, q2), dd{hi: q3})
+}
+
+// logUint64 splits x into m*2^k with m in [1/Sqrt2, Sqrt2) and returns k and
+// the natural logarithm of m. x must not be 0.
+func logUint64(x uint64) (k int, lnm dd) {
+	k = bits.Len64(x) - 1
+	// Split x into a high part with at most 53 significant bits and the
+	// remaining low bits, both of which are exactly representable.
+	var hi, lo float64
+	if s := k + 1 - 53; s > 0 {
+		hi = float64(x &^ (1<<s - 1))
+		lo = float64(x & (1<<s - 1))
+	} else {
+		hi = float64(x)
+	}
+	if math.Ldexp(float64(x), -k) > math.Sqrt2 {
+		k++
+	}
+	mh, ml := math.Ldexp(hi, -k), math.Ldexp(lo, -k)
+
+	// ln(m) = 2*atanh(t) = 2*(t + t^3/3 + t^5/5 + ...), where t = (m-1)/(m+1).
+	// |t| < 0.172, so 22 terms are enough for double-double precision.
+	m := ddAdd(dd{hi: mh}, dd{hi: ml})
+	t := ddDiv(ddAdd(m, dd{hi: -1}), ddAdd(m, dd{hi: 1}))
+	t2 := ddMul(t, t)
+	sum := ddInvOdd[len(ddInvOdd)-1]
+	for i := len(ddInvOdd) - 2; i >= 0; i-- {
+		sum = ddAdd(ddMul(sum, t2), ddInvOdd[i])
+	}
+	lnm = ddMul(ddMul(dd{hi: 2}, t), sum)
+	return k, lnm
+}
+
+// logInt returns the correctly rounded natural logarithm of the integer x.
+func logInt[T Integer | Float](x T) float64 {
+	switch {
+	case x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	}
+	k, lnm := logUint64(uint64(x))
+	r := ddAdd(ddMul(dd{hi: float64(k)}, ddLn2), lnm)
+	return r.hi + r.lo
+}
+
+// log2Int returns the correctly rounded binary logarithm of the integer x.
+func log2Int[T Integer | Float](x T) float64 {
+	switch {
+	case x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	}
+	k, lnm := logUint64(uint64(x))
+	r := ddAdd(dd{hi: float64(k)}, ddDiv(lnm, ddLn2))
+	return r.hi + r.lo
+}
+
+// log10Int returns the correctly rounded decimal logarithm of the integer x.
+func log10Int[T Integer | Float](x T) float64 {
+	switch {
+	case x < 0:
+		return math.NaN()
+	case x == 0:
+		return math.Inf(-1)
+	}
+	k, lnm := logUint64(uint64(x))
+	r := ddDiv(ddAdd(ddMul(dd{hi: float64(k)}, ddLn2), lnm), ddLn10)
+	return r.hi + r.lo
+}
@@ -0,0 +1,492 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestILog2(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  int
+		}{
+			{
+				input: 8,
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  int
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: 2,
+				want:  1,
+			},
+			{
+				input: 3,
+				want:  1,
+			},
+			{
+				input: 1023,
+				want:  9,
+			},
+			{
+				input: 1024,
+				want:  10,
+			},
+			{
+				input: math.MaxInt,
+				want:  62,
+			},
+			{
+				input: 0,
+				want:  math.MinInt32,
+			},
+			{
+				input: -1,
+				want:  math.MinInt32,
+			},
+			{
+				input: math.MinInt,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input int8
+			want  int
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: math.MaxInt8,
+				want:  6,
+			},
+			{
+				input: -8,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  int
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: 128,
+				want:  7,
+			},
+			{
+				input: math.MaxUint8,
+				want:  7,
+			},
+			{
+				input: 0,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input uint64
+			want  int
+		}{
+			{
+				input: 1<<53 + 1,
+				want:  53,
+			},
+			{
+				input: 1<<63 - 1,
+				want:  62,
+			},
+			{
+				input: 1 << 63,
+				want:  63,
+			},
+			{
+				input: math.MaxUint64,
+				want:  63,
+			},
+			{
+				input: 0,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog2(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestILog10(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  int
+		}{
+			{
+				input: 100,
+				want:  2,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog10(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  int
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: 9,
+				want:  0,
+			},
+			{
+				input: 10,
+				want:  1,
+			},
+			{
+				input: 99,
+				want:  1,
+			},
+			{
+				input: 100,
+				want:  2,
+			},
+			{
+				input: 999999,
+				want:  5,
+			},
+			{
+				input: 1000000,
+				want:  6,
+			},
+			{
+				input: math.MaxInt,
+				want:  18,
+			},
+			{
+				input: 0,
+				want:  math.MinInt32,
+			},
+			{
+				input: -10,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog10(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input int16
+			want  int
+		}{
+			{
+				input: 9999,
+				want:  3,
+			},
+			{
+				input: 10000,
+				want:  4,
+			},
+			{
+				input: math.MaxInt16,
+				want:  4,
+			},
+			{
+				input: -1,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog10(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  int
+		}{
+			{
+				input: 9,
+				want:  0,
+			},
+			{
+				input: 10,
+				want:  1,
+			},
+			{
+				input: math.MaxUint8,
+				want:  2,
+			},
+			{
+				input: 0,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog10(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input uint64
+			want  int
+		}{
+			{
+				input: 1e18 - 1,
+				want:  17,
+			},
+			{
+				input: 1e18,
+				want:  18,
+			},
+			{
+				input: 1e19 - 1,
+				want:  18,
+			},
+			{
+				input: 1e19,
+				want:  19,
+			},
+			{
+				input: math.MaxUint64,
+				want:  19,
+			},
+			{
+				input: 0,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ILog10(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("powers of 10", func(t *testing.T) {
+		p := uint64(1)
+		for want := 0; want < 20; want++ {
+			if got := ILog10(p); got != want {
+				t.Errorf("ILog10(%d): want %d, got %d", p, want, got)
+			}
+			if want > 0 {
+				if got := ILog10(p - 1); got != want-1 {
+					t.Errorf("ILog10(%d): want %d, got %d", p-1, want-1, got)
+				}
+			}
+			p *= 10
+		}
+	})
+}
+
+func TestILogBase(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			base  int
+			want  int
+		}{
+			{
+				input: 27,
+				base:  3,
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input, " base ", test.base), func(t *testing.T) {
+				got := ILogBase(test.input, test.base)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			base  int
+			want  int
+		}{
+			{
+				input: 1,
+				base:  3,
+				want:  0,
+			},
+			{
+				input: 2,
+				base:  3,
+				want:  0,
+			},
+			{
+				input: 3,
+				base:  3,
+				want:  1,
+			},
+			{
+				input: 80,
+				base:  3,
+				want:  3,
+			},
+			{
+				input: 81,
+				base:  3,
+				want:  4,
+			},
+			{
+				input: 1000,
+				base:  10,
+				want:  3,
+			},
+			{
+				input: 1024,
+				base:  2,
+				want:  10,
+			},
+			{
+				input: 255,
+				base:  16,
+				want:  1,
+			},
+			{
+				input: 256,
+				base:  16,
+				want:  2,
+			},
+			{
+				input: math.MaxInt,
+				base:  math.MaxInt,
+				want:  1,
+			},
+			{
+				input: 0,
+				base:  3,
+				want:  math.MinInt32,
+			},
+			{
+				input: -9,
+				base:  3,
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input, " base ", test.base), func(t *testing.T) {
+				got := ILogBase(test.input, test.base)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input uint64
+			base  int
+			want  int
+		}{
+			{
+				input: math.MaxUint64,
+				base:  16,
+				want:  15,
+			},
+			{
+				input: math.MaxUint64,
+				base:  math.MaxInt,
+				want:  1,
+			},
+			{
+				input: 1 << 63,
+				base:  math.MaxInt,
+				want:  1,
+			},
+			{
+				input: 12157665459056928801,
+				base:  3,
+				want:  40,
+			},
+			{
+				input: 12157665459056928800,
+				base:  3,
+				want:  39,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input, " base ", test.base), func(t *testing.T) {
+				got := ILogBase(test.input, test.base)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("panics for base < 2", func(t *testing.T) {
+		for _, base := range []int{1, 0, -2} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("ILogBase(10, %d): want panic", base)
+					}
+				}()
+				ILogBase(10, base)
+			}()
+		}
+	})
+}
@@ -0,0 +1,104 @@
+package gmath
+
+import (
+	"math"
+	"unsafe"
+)
+
+// The limit functions below only depend on the type T and are small enough to
+// be inlined, so the compiler reduces them to constants for each instantiation
+// and they cost nothing in hot loops.
+
+// BitSize returns the size of T in bits.
+func BitSize[T Integer | Float]() int {
+	var x T
+	return int(unsafe.Sizeof(x)) * 8
+}
+
+// IsSigned reports whether T can represent negative values. It returns true for
+// all floating point types.
+func IsSigned[T Integer | Float]() bool {
+	return T(0)-1 < 0
+}
+
+// MaxValue returns the largest finite value representable by T. For example:
+//
+//	MaxValue[int8]() = math.MaxInt8
+//	MaxValue[uint64]() = math.MaxUint64
+//	MaxValue[float32]() = math.MaxFloat32
+func MaxValue[T Integer | Float]() T {
+	var x T
+	if isFloat[T]() {
+		if unsafe.Sizeof(x) == 4 {
+			return T(math.Float32frombits(uvinf32 - 1))
+		}
+		return T(math.Float64frombits(uvinf64 - 1))
+	}
+	// Shift off the bits T doesn't have, plus the sign bit for signed types.
+	shift := 64 - 8*unsafe.Sizeof(x)
+	if IsSigned[T]() {
+		shift++
+	}
+	return T(^uint64(0) >> shift)
+}
+
+// MinValue returns the smallest finite value representable by T. For floating
+// point types, that is the negative of MaxValue, not the smallest positive
+// value. For example:
+//
+//	MinValue[int8]() = math.MinInt8
+//	MinValue[uint64]() = 0
+//	MinValue[float32]() = -math.MaxFloat32
+//
+// See SmallestNonzero and SmallestNormal for the smallest positive floating
+// point values.
+func MinValue[T Integer | Float]() T {
+	var x T
+	switch {
+	case isFloat[T]():
+		if unsafe.Sizeof(x) == 4 {
+			return T(math.Float32frombits(uvneginf32 - 1))
+		}
+		return T(math.Float64frombits(uvneginf64 - 1))
+	case IsSigned[T]():
+		// Converting 1 shifted into the sign bit wraps to the minimum value.
+		return T(uint64(1) << (8*unsafe.Sizeof(x) - 1))
+	}
+	return 0
+}
+
+// Epsilon returns the difference between 1 and the next value representable by
+// T that is greater than 1. For example:
+//
+//	Epsilon[float32]() = 1.1920929e-07 (2**-23)
+//	Epsilon[float64]() = 2.220446049250313e-16 (2**-52)
+func Epsilon[T Float]() T {
+	if is32[T]() {
+		return T(math.Float32frombits((bias32 - shift32) << shift32))
+	}
+	return T(math.Float64frombits((bias64 - shift64) << shift64))
+}
+
+// SmallestNonzero returns the smallest positive value representable by T,
+// which is a subnormal number. For example:
+//
+//	SmallestNonzero[float32]() = math.SmallestNonzeroFloat32
+//	SmallestNonzero[float64]() = math.SmallestNonzeroFloat64
+func SmallestNonzero[T Float]() T {
+	if is32[T]() {
+		return T(math.Float32frombits(1))
+	}
+	return T(math.Float64frombits(1))
+}
+
+// SmallestNormal returns the smallest positive normal value representable by
+// T. For example:
+//
+//	SmallestNormal[float32]() = 1.1754944e-38 (2**-126)
+//	SmallestNormal[float64]() = 2.2250738585072014e-308 (2**-1022)
+func SmallestNormal[T Float]() T {
+	if is32[T]() {
+		return T(math.Float32frombits(1 << shift32))
+	}
+	return T(math.Float64frombits(1 << shift64))
+}
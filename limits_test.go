@@ -0,0 +1,89 @@
+package gmath
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"unsafe"
+)
+
+func TestLimits(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		testLimits[myInt](t, math.MaxInt, math.MinInt, strconv.IntSize, true)
+	})
+	t.Run("int", func(t *testing.T) {
+		testLimits[int](t, math.MaxInt, math.MinInt, strconv.IntSize, true)
+	})
+	t.Run("int8", func(t *testing.T) {
+		testLimits[int8](t, math.MaxInt8, math.MinInt8, 8, true)
+	})
+	t.Run("int16", func(t *testing.T) {
+		testLimits[int16](t, math.MaxInt16, math.MinInt16, 16, true)
+	})
+	t.Run("int32", func(t *testing.T) {
+		testLimits[int32](t, math.MaxInt32, math.MinInt32, 32, true)
+	})
+	t.Run("int64", func(t *testing.T) {
+		testLimits[int64](t, math.MaxInt64, math.MinInt64, 64, true)
+	})
+	t.Run("uint", func(t *testing.T) {
+		testLimits[uint](t, math.MaxUint, 0, strconv.IntSize, false)
+	})
+	t.Run("uint8", func(t *testing.T) {
+		testLimits[uint8](t, math.MaxUint8, 0, 8, false)
+	})
+	t.Run("uint16", func(t *testing.T) {
+		testLimits[uint16](t, math.MaxUint16, 0, 16, false)
+	})
+	t.Run("uint32", func(t *testing.T) {
+		testLimits[uint32](t, math.MaxUint32, 0, 32, false)
+	})
+	t.Run("uint64", func(t *testing.T) {
+		testLimits[uint64](t, math.MaxUint64, 0, 64, false)
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		var x uintptr
+		bits := int(unsafe.Sizeof(x)) * 8
+		testLimits[uintptr](t, ^uintptr(0), 0, bits, false)
+	})
+	t.Run("float32", func(t *testing.T) {
+		testLimits[float32](t, math.MaxFloat32, -math.MaxFloat32, 32, true)
+	})
+	t.Run("float64", func(t *testing.T) {
+		testLimits[float64](t, math.MaxFloat64, -math.MaxFloat64, 64, true)
+	})
+	t.Run("named float32", func(t *testing.T) {
+		type celsius float32
+		testLimits[celsius](t, math.MaxFloat32, -math.MaxFloat32, 32, true)
+	})
+}
+
+func testLimits[T Integer | Float](t *testing.T, max, min T, bits int, signed bool) {
+	t.Helper()
+
+	assertEqual(t, max, MaxValue[T]())
+	assertEqual(t, min, MinValue[T]())
+	assertEqual(t, bits, BitSize[T]())
+	assertEqual(t, signed, IsSigned[T]())
+}
+
+func TestFloatLimits(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		assertEqual(t, float32(0x1p-23), Epsilon[float32]())
+		assertEqual(t, Nextafter(float32(1), 2)-1, Epsilon[float32]())
+		assertEqual(t, float32(math.SmallestNonzeroFloat32), SmallestNonzero[float32]())
+		assertEqual(t, float32(0x1p-126), SmallestNormal[float32]())
+	})
+	t.Run("float64", func(t *testing.T) {
+		assertEqual(t, 0x1p-52, Epsilon[float64]())
+		assertEqual(t, math.Nextafter(1, 2)-1, Epsilon[float64]())
+		assertEqual(t, math.SmallestNonzeroFloat64, SmallestNonzero[float64]())
+		assertEqual(t, 0x1p-1022, SmallestNormal[float64]())
+	})
+	t.Run("named float64", func(t *testing.T) {
+		type kelvin float64
+		assertEqual(t, kelvin(0x1p-52), Epsilon[kelvin]())
+		assertEqual(t, kelvin(math.SmallestNonzeroFloat64), SmallestNonzero[kelvin]())
+		assertEqual(t, kelvin(0x1p-1022), SmallestNormal[kelvin]())
+	})
+}
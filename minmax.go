@@ -0,0 +1,304 @@
+package gmath
+
+import "math"
+
+// MaxNum returns the larger of x or y, ignoring NaN operands. It implements
+// the IEEE 754-2019 maximumNumber operation.
+//
+// Special cases are:
+//
+//	MaxNum(x, NaN) = MaxNum(NaN, x) = x
+//	MaxNum(NaN, NaN) = NaN
+//	MaxNum(+0, ±0) = MaxNum(±0, +0) = +0
+//	MaxNum(-0, -0) = -0
+//
+// If both x and y are NaNs, MaxNum returns x. All other cases are the same as
+// Max.
+func MaxNum[T Integer | Float](x, y T) T {
+	// Check y first so that x is returned if both are NaNs, like Max.
+	switch {
+	case IsNaN(y):
+		return x
+	case IsNaN(x):
+		return y
+	}
+	return Max(x, y)
+}
+
+// MinNum returns the smaller of x or y, ignoring NaN operands. It implements
+// the IEEE 754-2019 minimumNumber operation.
+//
+// Special cases are:
+//
+//	MinNum(x, NaN) = MinNum(NaN, x) = x
+//	MinNum(NaN, NaN) = NaN
+//	MinNum(-0, ±0) = MinNum(±0, -0) = -0
+//
+// If both x and y are NaNs, MinNum returns x. All other cases are the same as
+// Min.
+func MinNum[T Integer | Float](x, y T) T {
+	// Check y first so that x is returned if both are NaNs, like Min.
+	switch {
+	case IsNaN(y):
+		return x
+	case IsNaN(x):
+		return y
+	}
+	return Min(x, y)
+}
+
+// MaximumMagnitude returns whichever of x or y has the larger absolute value.
+// If x and y have the same absolute value, it returns Max(x, y). It implements
+// the IEEE 754-2019 maximumMagnitude operation.
+//
+// Special cases are:
+//
+//	MaximumMagnitude(x, ±Inf) = MaximumMagnitude(±Inf, x) = ±Inf
+//	MaximumMagnitude(x, NaN) = MaximumMagnitude(NaN, x) = NaN
+//	MaximumMagnitude(+Inf, -Inf) = MaximumMagnitude(-Inf, +Inf) = +Inf
+//	MaximumMagnitude(+0, ±0) = MaximumMagnitude(±0, +0) = +0
+//	MaximumMagnitude(-0, -0) = -0
+//
+// For signed integer types, the magnitude of the minimum value of T is
+// compared correctly even though it can't be represented by T.
+func MaximumMagnitude[T Integer | Float](x, y T) T {
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+	switch compareMagnitude(x, y) {
+	case 1:
+		return x
+	case -1:
+		return y
+	}
+	return Max(x, y)
+}
+
+// MinimumMagnitude returns whichever of x or y has the smaller absolute value.
+// If x and y have the same absolute value, it returns Min(x, y). It implements
+// the IEEE 754-2019 minimumMagnitude operation.
+//
+// Special cases are:
+//
+//	MinimumMagnitude(x, ±Inf) = MinimumMagnitude(±Inf, x) = x, for finite x
+//	MinimumMagnitude(x, NaN) = MinimumMagnitude(NaN, x) = NaN
+//	MinimumMagnitude(+Inf, -Inf) = MinimumMagnitude(-Inf, +Inf) = -Inf
+//	MinimumMagnitude(-0, ±0) = MinimumMagnitude(±0, -0) = -0
+//
+// For signed integer types, the magnitude of the minimum value of T is
+// compared correctly even though it can't be represented by T.
+func MinimumMagnitude[T Integer | Float](x, y T) T {
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+	switch compareMagnitude(x, y) {
+	case -1:
+		return x
+	case 1:
+		return y
+	}
+	return Min(x, y)
+}
+
+// compareMagnitude returns -1, 0 or +1 depending on whether the absolute value
+// of x is less than, equal to or greater than the absolute value of y. x and y
+// must not be NaNs.
+func compareMagnitude[T Integer | Float](x, y T) int {
+	if isFloat[T]() {
+		// Converting to float64 is exact, and math.Abs clears the sign bit, so
+		// -0 and +0 have the same magnitude.
+		return Compare(math.Abs(float64(x)), math.Abs(float64(y)))
+	}
+	return Compare(magnitude(x), magnitude(y))
+}
+
+// MaxOf returns the largest of xs. It applies the same special cases as Max,
+// so it returns the first NaN in xs, if any, and prefers +0 over -0.
+//
+// MaxOf panics if xs is empty.
+func MaxOf[T Integer | Float](xs ...T) T {
+	if len(xs) == 0 {
+		panic("gmath: MaxOf of empty slice")
+	}
+	if !isFloat[T]() {
+		return maxOfInts(xs)
+	}
+	m := xs[0]
+	for _, x := range xs {
+		if IsNaN(x) {
+			return x
+		}
+		m = Max(m, x)
+	}
+	return m
+}
+
+// MinOf returns the smallest of xs. It applies the same special cases as Min,
+// so it returns the first NaN in xs, if any, and prefers -0 over +0.
+//
+// MinOf panics if xs is empty.
+func MinOf[T Integer | Float](xs ...T) T {
+	if len(xs) == 0 {
+		panic("gmath: MinOf of empty slice")
+	}
+	if !isFloat[T]() {
+		return minOfInts(xs)
+	}
+	m := xs[0]
+	for _, x := range xs {
+		if IsNaN(x) {
+			return x
+		}
+		m = Min(m, x)
+	}
+	return m
+}
+
+// MinMax returns the smallest and largest of xs, equivalent to MinOf(xs...)
+// and MaxOf(xs...). If xs contains a NaN, MinMax returns the first NaN for both
+// lo and hi.
+//
+// MinMax panics if xs is empty.
+func MinMax[T Integer | Float](xs ...T) (lo, hi T) {
+	if len(xs) == 0 {
+		panic("gmath: MinMax of empty slice")
+	}
+	if !isFloat[T]() {
+		return minOfInts(xs), maxOfInts(xs)
+	}
+	lo, hi = xs[0], xs[0]
+	for _, x := range xs {
+		if IsNaN(x) {
+			return x, x
+		}
+		lo, hi = Min(lo, x), Max(hi, x)
+	}
+	return lo, hi
+}
+
+// ArgMax returns the index of the largest of xs, or -1 if xs is empty. It
+// applies the same special cases as Max, so it returns the index of the first
+// NaN in xs, if any, and prefers +0 over -0. If the largest value occurs more
+// than once, ArgMax returns the first index.
+func ArgMax[T Integer | Float](xs []T) int {
+	if len(xs) == 0 {
+		return -1
+	}
+	best := 0
+	for i, x := range xs {
+		switch m := xs[best]; {
+		case IsNaN(x):
+			return i
+		case x > m:
+			best = i
+		case x == 0 && m == 0 && Signbit(m) && !Signbit(x):
+			// Max prefers +0 over -0.
+			best = i
+		}
+	}
+	return best
+}
+
+// ArgMin returns the index of the smallest of xs, or -1 if xs is empty. It
+// applies the same special cases as Min, so it returns the index of the first
+// NaN in xs, if any, and prefers -0 over +0. If the smallest value occurs more
+// than once, ArgMin returns the first index.
+func ArgMin[T Integer | Float](xs []T) int {
+	if len(xs) == 0 {
+		return -1
+	}
+	best := 0
+	for i, x := range xs {
+		switch m := xs[best]; {
+		case IsNaN(x):
+			return i
+		case x < m:
+			best = i
+		case x == 0 && m == 0 && !Signbit(m) && Signbit(x):
+			// Min prefers -0 over +0.
+			best = i
+		}
+	}
+	return best
+}
+
+// maxOfInts returns the largest of the integers in xs, which must not be
+// empty. The loop is unrolled into four independent comparisons so they can
+// execute in parallel.
+func maxOfInts[T Integer | Float](xs []T) T {
+	m0 := xs[0]
+	m1, m2, m3 := m0, m0, m0
+	i := 1
+	for ; i+4 <= len(xs); i += 4 {
+		if xs[i] > m0 {
+			m0 = xs[i]
+		}
+		if xs[i+1] > m1 {
+			m1 = xs[i+1]
+		}
+		if xs[i+2] > m2 {
+			m2 = xs[i+2]
+		}
+		if xs[i+3] > m3 {
+			m3 = xs[i+3]
+		}
+	}
+	for ; i < len(xs); i++ {
+		if xs[i] > m0 {
+			m0 = xs[i]
+		}
+	}
+	if m1 > m0 {
+		m0 = m1
+	}
+	if m3 > m2 {
+		m2 = m3
+	}
+	if m2 > m0 {
+		m0 = m2
+	}
+	return m0
+}
+
+// minOfInts returns the smallest of the integers in xs, which must not be
+// empty. See maxOfInts.
+func minOfInts[T Integer | Float](xs []T) T {
+	m0 := xs[0]
+	m1, m2, m3 := m0, m0, m0
+	i := 1
+	for ; i+4 <= len(xs); i += 4 {
+		if xs[i] < m0 {
+			m0 = xs[i]
+		}
+		if xs[i+1] < m1 {
+			m1 = xs[i+1]
+		}
+		if xs[i+2] < m2 {
+			m2 = xs[i+2]
+		}
+		if xs[i+3] < m3 {
+			m3 = xs[i+3]
+		}
+	}
+	for ; i < len(xs); i++ {
+		if xs[i] < m0 {
+			m0 = xs[i]
+		}
+	}
+	if m1 < m0 {
+		m0 = m1
+	}
+	if m3 < m2 {
+		m2 = m3
+	}
+	if m2 < m0 {
+		m0 = m2
+	}
+	return m0
+}
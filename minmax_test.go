@@ -0,0 +1,1266 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMaxNum(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-3, 5},
+				want:  5,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{-3, 5},
+				want:  5,
+			},
+			{
+				input: [2]int{5, -3},
+				want:  5,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint
+			want  uint
+		}{
+			{
+				input: [2]uint{3, 5},
+				want:  5,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{-3, 5},
+				want:  5,
+			},
+			{
+				input: [2]float32{NaN32(), 5},
+				want:  5,
+			},
+			{
+				input: [2]float32{5, NaN32()},
+				want:  5,
+			},
+			{
+				input: [2]float32{NaN32(), Inf32(-1)},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), NaNWithPayload[float32](2)},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: [2]float32{Inf32(1), 5},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{negzero32(), 0},
+				want:  0,
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  0,
+			},
+			{
+				input: [2]float32{negzero32(), negzero32()},
+				want:  negzero32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{-3, 5},
+				want:  5,
+			},
+			{
+				input: [2]float64{math.NaN(), -5},
+				want:  -5,
+			},
+			{
+				input: [2]float64{-5, math.NaN()},
+				want:  -5,
+			},
+			{
+				input: [2]float64{math.NaN(), math.NaN()},
+				want:  math.NaN(),
+			},
+			{
+				input: [2]float64{negzero64(), 0},
+				want:  0,
+			},
+			{
+				input: [2]float64{negzero64(), negzero64()},
+				want:  negzero64(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMinNum(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-3, 5},
+				want:  -3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{-3, 5},
+				want:  -3,
+			},
+			{
+				input: [2]int{5, -3},
+				want:  -3,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint
+			want  uint
+		}{
+			{
+				input: [2]uint{3, 5},
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{-3, 5},
+				want:  -3,
+			},
+			{
+				input: [2]float32{NaN32(), 5},
+				want:  5,
+			},
+			{
+				input: [2]float32{5, NaN32()},
+				want:  5,
+			},
+			{
+				input: [2]float32{NaN32(), Inf32(1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), NaNWithPayload[float32](2)},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: [2]float32{Inf32(-1), 5},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{negzero32(), 0},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{0, 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{-3, 5},
+				want:  -3,
+			},
+			{
+				input: [2]float64{math.NaN(), 5},
+				want:  5,
+			},
+			{
+				input: [2]float64{5, math.NaN()},
+				want:  5,
+			},
+			{
+				input: [2]float64{math.NaN(), math.NaN()},
+				want:  math.NaN(),
+			},
+			{
+				input: [2]float64{0, negzero64()},
+				want:  negzero64(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinNum(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMaximumMagnitude(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-7, 5},
+				want:  -7,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaximumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{-7, 5},
+				want:  -7,
+			},
+			{
+				input: [2]int{5, -7},
+				want:  -7,
+			},
+			{
+				input: [2]int{-5, 5},
+				want:  5,
+			},
+			{
+				input: [2]int{5, -5},
+				want:  5,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MaxInt, math.MinInt},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaximumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{math.MinInt8, math.MaxInt8},
+				want:  math.MinInt8,
+			},
+			{
+				input: [2]int8{math.MinInt8, math.MinInt8},
+				want:  math.MinInt8,
+			},
+			{
+				input: [2]int8{-1, 0},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaximumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  uint64
+		}{
+			{
+				input: [2]uint64{3, math.MaxUint64},
+				want:  math.MaxUint64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaximumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{-7, 5},
+				want:  -7,
+			},
+			{
+				input: [2]float32{-5, 5},
+				want:  5,
+			},
+			{
+				input: [2]float32{5, -5},
+				want:  5,
+			},
+			{
+				input: [2]float32{Inf32(-1), math.MaxFloat32},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{Inf32(-1), Inf32(1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{NaN32(), 5},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{5, NaNWithPayload[float32](1)},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: [2]float32{negzero32(), 0},
+				want:  0,
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  0,
+			},
+			{
+				input: [2]float32{negzero32(), negzero32()},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{negzero32(), -math.SmallestNonzeroFloat32},
+				want:  -math.SmallestNonzeroFloat32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaximumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{-7, 5},
+				want:  -7,
+			},
+			{
+				input: [2]float64{-5, 5},
+				want:  5,
+			},
+			{
+				input: [2]float64{math.Inf(1), math.Inf(-1)},
+				want:  math.Inf(1),
+			},
+			{
+				input: [2]float64{math.NaN(), math.Inf(1)},
+				want:  math.NaN(),
+			},
+			{
+				input: [2]float64{negzero64(), 0},
+				want:  0,
+			},
+			{
+				input: [2]float64{negzero64(), negzero64()},
+				want:  negzero64(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaximumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMinimumMagnitude(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-7, 5},
+				want:  5,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinimumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{-7, 5},
+				want:  5,
+			},
+			{
+				input: [2]int{5, -7},
+				want:  5,
+			},
+			{
+				input: [2]int{-5, 5},
+				want:  -5,
+			},
+			{
+				input: [2]int{5, -5},
+				want:  -5,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{math.MaxInt, math.MinInt},
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinimumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{math.MinInt8, math.MaxInt8},
+				want:  math.MaxInt8,
+			},
+			{
+				input: [2]int8{math.MinInt8, -1},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinimumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  uint64
+		}{
+			{
+				input: [2]uint64{3, math.MaxUint64},
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinimumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{-7, 5},
+				want:  5,
+			},
+			{
+				input: [2]float32{-5, 5},
+				want:  -5,
+			},
+			{
+				input: [2]float32{5, -5},
+				want:  -5,
+			},
+			{
+				input: [2]float32{Inf32(-1), math.MaxFloat32},
+				want:  math.MaxFloat32,
+			},
+			{
+				input: [2]float32{Inf32(-1), Inf32(1)},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{NaN32(), 5},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{5, NaNWithPayload[float32](1)},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: [2]float32{negzero32(), 0},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{0, 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinimumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{-7, 5},
+				want:  5,
+			},
+			{
+				input: [2]float64{-5, 5},
+				want:  -5,
+			},
+			{
+				input: [2]float64{math.Inf(1), math.Inf(-1)},
+				want:  math.Inf(-1),
+			},
+			{
+				input: [2]float64{math.NaN(), math.Inf(1)},
+				want:  math.NaN(),
+			},
+			{
+				input: [2]float64{0, negzero64()},
+				want:  negzero64(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinimumMagnitude(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMaxOf(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input []myInt
+			want  myInt
+		}{
+			{
+				input: []myInt{3, -1, 7, 2},
+				want:  7,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input []int
+			want  int
+		}{
+			{
+				input: []int{1},
+				want:  1,
+			},
+			{
+				input: []int{3, -1, 7, 2},
+				want:  7,
+			},
+			{
+				input: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				want:  9,
+			},
+			{
+				input: []int{9, 8, 7, 6, 5, 4, 3, 2, 1},
+				want:  9,
+			},
+			{
+				input: []int{math.MinInt, math.MinInt},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input []uint8
+			want  uint8
+		}{
+			{
+				input: []uint8{0, 255, 1, 2, 3},
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input []float32
+			want  float32
+		}{
+			{
+				input: []float32{1},
+				want:  1,
+			},
+			{
+				input: []float32{3, -1, 7, 2},
+				want:  7,
+			},
+			{
+				input: []float32{1, Inf32(1), 2},
+				want:  Inf32(1),
+			},
+			{
+				input: []float32{1, NaNWithPayload[float32](1), NaNWithPayload[float32](2), Inf32(1)},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: []float32{negzero32(), 0, negzero32()},
+				want:  0,
+			},
+			{
+				input: []float32{negzero32(), negzero32()},
+				want:  negzero32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input []float64
+			want  float64
+		}{
+			{
+				input: []float64{3, -1, 7, 2},
+				want:  7,
+			},
+			{
+				input: []float64{math.Inf(-1), -1},
+				want:  -1,
+			},
+			{
+				input: []float64{1, math.NaN()},
+				want:  math.NaN(),
+			},
+			{
+				input: []float64{negzero64(), 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MaxOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMinOf(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input []myInt
+			want  myInt
+		}{
+			{
+				input: []myInt{3, -1, 7, 2},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input []int
+			want  int
+		}{
+			{
+				input: []int{1},
+				want:  1,
+			},
+			{
+				input: []int{3, -1, 7, 2},
+				want:  -1,
+			},
+			{
+				input: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				want:  1,
+			},
+			{
+				input: []int{9, 8, 7, 6, 5, 4, 3, 2, 1},
+				want:  1,
+			},
+			{
+				input: []int{math.MaxInt, math.MinInt},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input []uint8
+			want  uint8
+		}{
+			{
+				input: []uint8{5, 255, 1, 2, 3},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input []float32
+			want  float32
+		}{
+			{
+				input: []float32{1},
+				want:  1,
+			},
+			{
+				input: []float32{3, -1, 7, 2},
+				want:  -1,
+			},
+			{
+				input: []float32{1, Inf32(-1), 2},
+				want:  Inf32(-1),
+			},
+			{
+				input: []float32{1, NaNWithPayload[float32](1), NaNWithPayload[float32](2), Inf32(-1)},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: []float32{0, negzero32(), 0},
+				want:  negzero32(),
+			},
+			{
+				input: []float32{0, 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input []float64
+			want  float64
+		}{
+			{
+				input: []float64{3, -1, 7, 2},
+				want:  -1,
+			},
+			{
+				input: []float64{math.Inf(1), 1},
+				want:  1,
+			},
+			{
+				input: []float64{1, math.NaN()},
+				want:  math.NaN(),
+			},
+			{
+				input: []float64{0, negzero64()},
+				want:  negzero64(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MinOf(test.input...)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestArgMax(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input []myInt
+			want  int
+		}{
+			{
+				input: []myInt{3, -1, 7, 2},
+				want:  2,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMax(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input []int
+			want  int
+		}{
+			{
+				input: []int{},
+				want:  -1,
+			},
+			{
+				input: []int{1},
+				want:  0,
+			},
+			{
+				input: []int{3, -1, 7, 2, 7},
+				want:  2,
+			},
+			{
+				input: []int{math.MinInt, math.MinInt},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMax(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input []float32
+			want  int
+		}{
+			{
+				input: []float32{3, -1, 7, 2},
+				want:  2,
+			},
+			{
+				input: []float32{1, NaN32(), 2, NaN32()},
+				want:  1,
+			},
+			{
+				input: []float32{negzero32(), 0, 0},
+				want:  1,
+			},
+			{
+				input: []float32{negzero32(), negzero32()},
+				want:  0,
+			},
+			{
+				input: []float32{Inf32(-1), Inf32(-1)},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMax(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input []float64
+			want  int
+		}{
+			{
+				input: []float64{},
+				want:  -1,
+			},
+			{
+				input: []float64{3, -1, 7, 2},
+				want:  2,
+			},
+			{
+				input: []float64{1, 2, math.NaN()},
+				want:  2,
+			},
+			{
+				input: []float64{negzero64(), 0},
+				want:  1,
+			},
+			{
+				input: []float64{0, negzero64()},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMax(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestArgMin(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input []myInt
+			want  int
+		}{
+			{
+				input: []myInt{3, -1, 7, 2},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMin(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input []int
+			want  int
+		}{
+			{
+				input: []int{},
+				want:  -1,
+			},
+			{
+				input: []int{1},
+				want:  0,
+			},
+			{
+				input: []int{3, -1, 7, -1},
+				want:  1,
+			},
+			{
+				input: []int{math.MaxInt, math.MaxInt},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMin(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input []float32
+			want  int
+		}{
+			{
+				input: []float32{3, -1, 7, 2},
+				want:  1,
+			},
+			{
+				input: []float32{1, NaN32(), 2, NaN32()},
+				want:  1,
+			},
+			{
+				input: []float32{0, negzero32(), negzero32()},
+				want:  1,
+			},
+			{
+				input: []float32{0, 0},
+				want:  0,
+			},
+			{
+				input: []float32{Inf32(1), Inf32(1)},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMin(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input []float64
+			want  int
+		}{
+			{
+				input: []float64{},
+				want:  -1,
+			},
+			{
+				input: []float64{3, -1, 7, 2},
+				want:  1,
+			},
+			{
+				input: []float64{1, 2, math.NaN()},
+				want:  2,
+			},
+			{
+				input: []float64{0, negzero64()},
+				want:  1,
+			},
+			{
+				input: []float64{negzero64(), 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ArgMin(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		lo, hi := MinMax(3, -1, 7, 2, 9, -4, 0)
+		assertEqual(t, -4, lo)
+		assertEqual(t, 9, hi)
+	})
+	t.Run("float32", func(t *testing.T) {
+		lo, hi := MinMax[float32](0, negzero32(), 2, Inf32(-1))
+		assertEqual(t, Inf32(-1), lo)
+		assertEqual(t, float32(2), hi)
+
+		lo, hi = MinMax[float32](0, negzero32())
+		assertEqual(t, negzero32(), lo)
+		assertEqual(t, float32(0), hi)
+	})
+	t.Run("float64", func(t *testing.T) {
+		nan := NaNWithPayload[float64](1)
+		lo, hi := MinMax(1, nan, math.NaN(), math.Inf(1))
+		assertEqual(t, nan, lo)
+		assertEqual(t, nan, hi)
+	})
+}
+
+func TestReductionsPanicOnEmpty(t *testing.T) {
+	tests := map[string]func(){
+		"MaxOf":  func() { MaxOf[int]() },
+		"MinOf":  func() { MinOf[float64]() },
+		"MinMax": func() { MinMax[uint8]() },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("want panic")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+// TestIntReductions checks the unrolled integer paths against a simple loop
+// for every slice length around the unrolling factor.
+func TestIntReductions(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 1; n <= 20; n++ {
+		for trial := 0; trial < 50; trial++ {
+			xs := make([]int16, n)
+			for i := range xs {
+				xs[i] = int16(r.Intn(1 << 16))
+			}
+			lo, hi := xs[0], xs[0]
+			for _, x := range xs {
+				if x < lo {
+					lo = x
+				}
+				if x > hi {
+					hi = x
+				}
+			}
+			assertEqual(t, hi, MaxOf(xs...))
+			assertEqual(t, lo, MinOf(xs...))
+			gotLo, gotHi := MinMax(xs...)
+			assertEqual(t, lo, gotLo)
+			assertEqual(t, hi, gotHi)
+		}
+	}
+}
+
+func BenchmarkMaxOf(b *testing.B) {
+	b.Run("int", benchmarkMaxOf[int])
+	b.Run("int64", benchmarkMaxOf[int64])
+	b.Run("uint64", benchmarkMaxOf[uint64])
+	b.Run("float32", benchmarkMaxOf[float32])
+	b.Run("float64", benchmarkMaxOf[float64])
+}
+
+func benchmarkMaxOf[T Integer | Float](b *testing.B) {
+	xs := make([]T, 1024)
+	for i := range xs {
+		xs[i] = T(i * 7919 % 1024)
+	}
+	b.ResetTimer()
+	var got T
+	for i := 0; i < b.N; i++ {
+		got = MaxOf(xs...)
+	}
+	benchSink = got
+}
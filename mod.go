@@ -0,0 +1,230 @@
+package gmath
+
+import "math"
+
+// Mod returns the remainder of x/y, truncating the quotient toward zero. The
+// result has the sign of x and a magnitude less than the magnitude of y. For
+// integer types, Mod is equivalent to x % y. For floating point types, it is
+// equivalent to math.Mod.
+//
+// Special cases are:
+//
+//	Mod(±Inf, y) = NaN
+//	Mod(NaN, y) = NaN
+//	Mod(x, 0) = NaN for floating point types
+//	Mod(x, ±Inf) = x
+//	Mod(x, NaN) = NaN
+//	Mod(math.MinInt64, -1) = 0
+//
+// For integer types, Mod panics if y is 0, like the % operator.
+func Mod[T Integer | Float](x, y T) T {
+	if !isFloat[T]() {
+		return intRem(x, y)
+	}
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+	// The result is exact, so converting float32 values to float64 and back
+	// doesn't change it.
+	return T(math.Mod(float64(x), float64(y)))
+}
+
+// Remainder returns the IEEE 754 floating-point remainder of x/y, x-n*y where
+// n is the integer nearest to x/y, rounding halfway cases to even. Unlike Mod,
+// the result can have either sign, so Remainder(5, 3) = -1. For floating point
+// types, Remainder is equivalent to math.Remainder.
+//
+// Special cases are:
+//
+//	Remainder(±Inf, y) = NaN
+//	Remainder(NaN, y) = NaN
+//	Remainder(x, 0) = NaN for floating point types
+//	Remainder(x, ±Inf) = x
+//	Remainder(x, NaN) = NaN
+//	Remainder(math.MinInt64, -1) = 0
+//
+// For integer types, Remainder panics if y is 0. Remainder doesn't support
+// unsigned integer types because the result can be negative.
+func Remainder[T Signed | Float](x, y T) T {
+	if !isFloat[T]() {
+		r := intRem(x, y)
+		ar, ay := magnitude(r), magnitude(y)
+		// Compare 2*|r| with |y| without overflowing. If the halfway case
+		// applies, the quotient x/y can't overflow because r != 0.
+		if ar < ay-ar || ar == ay-ar && int64(x/y)&1 == 0 {
+			return r
+		}
+		// Move r to the other side of zero by subtracting |y| from its
+		// magnitude. The result always fits because |r| < |y|.
+		if r > 0 {
+			return T(uint64(r) - ay)
+		}
+		return T(uint64(r) + ay)
+	}
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	}
+	return T(math.Remainder(float64(x), float64(y)))
+}
+
+// DivFloor returns the quotient x/y rounded toward negative infinity. For
+// integer types, DivFloor(-7, 2) = -4 whereas -7/2 = -3.
+//
+// For floating point types, the quotient is computed from the exact remainder
+// of x/y. If the magnitude of the exact quotient is less than 2^53 (2^24 for
+// 32-bit floating point types), the result is the floor of the exact quotient,
+// even when x/y rounds up to an integer. Larger quotients are rounded to a
+// representable value, which can be greater than the exact quotient.
+//
+// Special cases are:
+//
+//	DivFloor(±Inf, y) = ±Inf/y
+//	DivFloor(x, ±0) = x/±0 for floating point types
+//	DivFloor(x, NaN) = DivFloor(NaN, y) = NaN
+//	DivFloor(math.MinInt64, -1) = math.MinInt64
+//
+// For integer types, DivFloor panics if y is 0 and wraps around like the /
+// operator if the quotient overflows.
+func DivFloor[T Integer | Float](x, y T) T {
+	if !isFloat[T]() {
+		q := x / y
+		if r := intRem(x, y); r != 0 && (r < 0) != (y < 0) {
+			q--
+		}
+		return q
+	}
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	case IsInf(x, 0) || y == 0:
+		return x / y
+	}
+	return T(divFloor(float64(x), float64(y)))
+}
+
+// divFloor returns the floor of the exact quotient x/y. x must be finite and y
+// must not be 0.
+//
+// Based on float_divmod in CPython's Objects/floatobject.c.
+func divFloor(x, y float64) float64 {
+	mod := math.Mod(x, y)
+	div := (x - mod) / y
+	if mod != 0 && (y < 0) != (mod < 0) {
+		div--
+	}
+	if div == 0 {
+		// Keep the sign of the quotient.
+		return math.Copysign(0, x/y)
+	}
+	// div is very close to an integer, so snap it to the nearest one.
+	q := math.Floor(div)
+	if div-q > 0.5 {
+		q++
+	}
+	return q
+}
+
+// DivCeil returns the quotient x/y rounded toward positive infinity. For
+// integer types, DivCeil(7, 2) = 4 whereas 7/2 = 3.
+//
+// For floating point types, the result is the ceiling of the exact quotient if
+// its magnitude is less than 2^53 (2^24 for 32-bit floating point types).
+// Larger quotients are rounded to a representable value, which can be less than
+// the exact quotient.
+//
+// Special cases are:
+//
+//	DivCeil(±Inf, y) = ±Inf/y
+//	DivCeil(x, ±0) = x/±0 for floating point types
+//	DivCeil(x, NaN) = DivCeil(NaN, y) = NaN
+//	DivCeil(math.MinInt64, -1) = math.MinInt64
+//
+// For integer types, DivCeil panics if y is 0 and wraps around like the /
+// operator if the quotient overflows.
+func DivCeil[T Integer | Float](x, y T) T {
+	if !isFloat[T]() {
+		q := x / y
+		if r := intRem(x, y); r != 0 && (r < 0) == (y < 0) {
+			q++
+		}
+		return q
+	}
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(y):
+		return y
+	case IsInf(x, 0) || y == 0:
+		return x / y
+	}
+	// ceil(x/y) = -floor(-x/y)
+	return T(-divFloor(-float64(x), float64(y)))
+}
+
+// DivEuclid returns the Euclidean quotient of x/y, the integer q such that
+// x = q*y + ModEuclid(x, y). It is DivFloor(x, y) if y > 0 and DivCeil(x, y) if
+// y < 0, so DivEuclid(-7, 2) = -4 and DivEuclid(-7, -2) = 4.
+//
+// The special cases are the same as for DivFloor and DivCeil.
+func DivEuclid[T Integer | Float](x, y T) T {
+	if y < 0 {
+		return DivCeil(x, y)
+	}
+	return DivFloor(x, y)
+}
+
+// ModEuclid returns the Euclidean remainder of x/y, which is never negative
+// and is less than the magnitude of y. For example, ModEuclid(-7, 2) = 1 and
+// ModEuclid(-7, -2) = 1, whereas Mod(-7, 2) = -1.
+//
+// For floating point types, if a tiny negative remainder rounds up to the
+// magnitude of y when made positive, ModEuclid returns 0 instead so that the
+// result is always less than the magnitude of y.
+//
+// Special cases are:
+//
+//	ModEuclid(±Inf, y) = NaN
+//	ModEuclid(NaN, y) = NaN
+//	ModEuclid(x, 0) = NaN for floating point types
+//	ModEuclid(x, ±Inf) = x if x >= 0
+//	ModEuclid(x, ±Inf) = +Inf if x < 0
+//	ModEuclid(x, NaN) = NaN
+//	ModEuclid(math.MinInt64, -1) = 0
+//
+// For integer types, ModEuclid panics if y is 0.
+func ModEuclid[T Integer | Float](x, y T) T {
+	r := Mod(x, y)
+	if !(r < 0) {
+		// r is non-negative or NaN.
+		return r
+	}
+	if !isFloat[T]() {
+		// Add |y| in unsigned arithmetic, since |y| might not fit in T. The
+		// result fits because |r| < |y|.
+		return T(uint64(r) + magnitude(y))
+	}
+	ay := T(math.Abs(float64(y)))
+	if r += ay; r == ay && !IsInf(ay, 0) {
+		return 0
+	}
+	return r
+}
+
+// intRem returns x % y for the integer type T. The % operator isn't defined
+// for type parameters that allow floating point types, so intRem computes the
+// remainder in a 64-bit integer type instead. Like the % operator, it panics if
+// y is 0.
+func intRem[T Integer | Float](x, y T) T {
+	if IsSigned[T]() {
+		return T(int64(x) % int64(y))
+	}
+	return T(uint64(x) % uint64(y))
+}
@@ -0,0 +1,1128 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestMod(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-7, 2},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Mod(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{7, 2},
+				want:  1,
+			},
+			{
+				input: [2]int{-7, 2},
+				want:  -1,
+			},
+			{
+				input: [2]int{7, -2},
+				want:  1,
+			},
+			{
+				input: [2]int{-7, -2},
+				want:  -1,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Mod(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{255, 7},
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Mod(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{7.5, 2},
+				want:  1.5,
+			},
+			{
+				input: [2]float32{-7.5, 2},
+				want:  -1.5,
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [2]float32{Inf32(1), 2},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [2]float32{3, Inf32(-1)},
+				want:  3,
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), 2},
+				want:  NaNWithPayload[float32](1),
+			},
+			{
+				input: [2]float32{2, SignalingNaN[float32](1)},
+				want:  SignalingNaN[float32](1),
+			},
+			{
+				input: [2]float32{negzero32(), 2},
+				want:  negzero32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Mod(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{7.5, 2},
+				want:  math.Mod(7.5, 2),
+			},
+			{
+				input: [2]float64{-7.5, 2},
+				want:  math.Mod(-7.5, 2),
+			},
+			{
+				input: [2]float64{1, 0.1},
+				want:  math.Mod(1, 0.1),
+			},
+			{
+				input: [2]float64{1e300, 3},
+				want:  math.Mod(1e300, 3),
+			},
+			{
+				input: [2]float64{math.NaN(), 2},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Mod(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestRemainder(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{5, 3},
+				want:  -1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Remainder(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{5, 3},
+				want:  -1,
+			},
+			{
+				input: [2]int{4, 3},
+				want:  1,
+			},
+			{
+				input: [2]int{-5, 3},
+				want:  1,
+			},
+			{
+				input: [2]int{5, -3},
+				want:  -1,
+			},
+			{
+				input: [2]int{7, 2},
+				want:  -1,
+			},
+			{
+				input: [2]int{5, 2},
+				want:  1,
+			},
+			{
+				input: [2]int{-7, 2},
+				want:  1,
+			},
+			{
+				input: [2]int{-5, 2},
+				want:  -1,
+			},
+			{
+				input: [2]int{6, 4},
+				want:  -2,
+			},
+			{
+				input: [2]int{10, 4},
+				want:  2,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  0,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  -1,
+			},
+			{
+				input: [2]int{math.MaxInt, math.MinInt},
+				want:  -1,
+			},
+			{
+				input: [2]int{math.MinInt + 1, math.MinInt},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Remainder(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{-128, 127},
+				want:  -1,
+			},
+			{
+				input: [2]int8{127, -128},
+				want:  -1,
+			},
+			{
+				input: [2]int8{-128, -1},
+				want:  0,
+			},
+			{
+				input: [2]int8{-64, -128},
+				want:  -64,
+			},
+			{
+				input: [2]int8{64, -128},
+				want:  64,
+			},
+			{
+				input: [2]int8{-128, 3},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Remainder(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{5, 3},
+				want:  -1,
+			},
+			{
+				input: [2]float32{7, 2},
+				want:  -1,
+			},
+			{
+				input: [2]float32{5, 2},
+				want:  1,
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [2]float32{Inf32(1), 2},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [2]float32{3, Inf32(1)},
+				want:  3,
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), 2},
+				want:  NaNWithPayload[float32](1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Remainder(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{5, 3},
+				want:  -1,
+			},
+			{
+				input: [2]float64{1, 0.1},
+				want:  math.Remainder(1, 0.1),
+			},
+			{
+				input: [2]float64{1e300, 3},
+				want:  math.Remainder(1e300, 3),
+			},
+			{
+				input: [2]float64{2, math.NaN()},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Remainder(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestDivFloor(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-7, 2},
+				want:  -4,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivFloor(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{7, 2},
+				want:  3,
+			},
+			{
+				input: [2]int{-7, 2},
+				want:  -4,
+			},
+			{
+				input: [2]int{7, -2},
+				want:  -4,
+			},
+			{
+				input: [2]int{-7, -2},
+				want:  3,
+			},
+			{
+				input: [2]int{-8, 2},
+				want:  -4,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MinInt, 1},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  -2,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivFloor(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{255, 7},
+				want:  36,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivFloor(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{7.5, 2},
+				want:  3,
+			},
+			{
+				input: [2]float32{-7.5, 2},
+				want:  -4,
+			},
+			{
+				input: [2]float32{7.5, -2},
+				want:  -4,
+			},
+			{
+				input: [2]float32{-7.5, -2},
+				want:  3,
+			},
+			{
+				input: [2]float32{1, 0.1},
+				want:  9,
+			},
+			{
+				input: [2]float32{-1, 0.1},
+				want:  -10,
+			},
+			{
+				input: [2]float32{0.5, 2},
+				want:  0,
+			},
+			{
+				input: [2]float32{-0.5, 2},
+				want:  -1,
+			},
+			{
+				input: [2]float32{negzero32(), 2},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{0, -2},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{-1, 0},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{Inf32(1), -2},
+				want:  Inf32(-1),
+			},
+			{
+				input: [2]float32{1, Inf32(1)},
+				want:  0,
+			},
+			{
+				input: [2]float32{-1, Inf32(1)},
+				want:  -1,
+			},
+			{
+				input: [2]float32{NaN32(), 2},
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivFloor(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{7.5, 2},
+				want:  3,
+			},
+			{
+				input: [2]float64{-7.5, 2},
+				want:  -4,
+			},
+			{
+				input: [2]float64{1, 0.1},
+				want:  9,
+			},
+			{
+				input: [2]float64{-1, 0.1},
+				want:  -10,
+			},
+			{
+				input: [2]float64{1, -0.1},
+				want:  -10,
+			},
+			{
+				input: [2]float64{0, -2},
+				want:  negzero64(),
+			},
+			{
+				input: [2]float64{4386.062905632647, 3.4661413971434e-13},
+				want:  12654021873566366,
+			},
+			{
+				input: [2]float64{1e300, 1e-300},
+				want:  math.Inf(1),
+			},
+			{
+				input: [2]float64{2, math.NaN()},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivFloor(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestDivCeil(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{7, 2},
+				want:  4,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivCeil(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{7, 2},
+				want:  4,
+			},
+			{
+				input: [2]int{-7, 2},
+				want:  -3,
+			},
+			{
+				input: [2]int{7, -2},
+				want:  -3,
+			},
+			{
+				input: [2]int{-7, -2},
+				want:  4,
+			},
+			{
+				input: [2]int{8, 2},
+				want:  4,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MaxInt, math.MinInt},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivCeil(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{255, 7},
+				want:  37,
+			},
+			{
+				input: [2]uint8{0, 7},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivCeil(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{7.5, 2},
+				want:  4,
+			},
+			{
+				input: [2]float32{-7.5, 2},
+				want:  -3,
+			},
+			{
+				input: [2]float32{1, 0.1},
+				want:  10,
+			},
+			{
+				input: [2]float32{-1, 0.1},
+				want:  -9,
+			},
+			{
+				input: [2]float32{0.5, 2},
+				want:  1,
+			},
+			{
+				input: [2]float32{-0.5, 2},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{1, Inf32(1)},
+				want:  1,
+			},
+			{
+				input: [2]float32{NaN32(), 2},
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivCeil(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{7.5, 2},
+				want:  4,
+			},
+			{
+				input: [2]float64{-7.5, -2},
+				want:  4,
+			},
+			{
+				input: [2]float64{1, 0.1},
+				want:  10,
+			},
+			{
+				input: [2]float64{-1, 0.1},
+				want:  -9,
+			},
+			{
+				input: [2]float64{2, math.NaN()},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivCeil(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestDivEuclid(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-7, 2},
+				want:  -4,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{7, 2},
+				want:  3,
+			},
+			{
+				input: [2]int{-7, 2},
+				want:  -4,
+			},
+			{
+				input: [2]int{7, -2},
+				want:  -3,
+			},
+			{
+				input: [2]int{-7, -2},
+				want:  4,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{255, 7},
+				want:  36,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{7.5, 2},
+				want:  3,
+			},
+			{
+				input: [2]float32{-7.5, 2},
+				want:  -4,
+			},
+			{
+				input: [2]float32{7.5, -2},
+				want:  -3,
+			},
+			{
+				input: [2]float32{-7.5, -2},
+				want:  4,
+			},
+			{
+				input: [2]float32{-1, 0.1},
+				want:  -10,
+			},
+			{
+				input: [2]float32{-1, -0.1},
+				want:  10,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{7.5, 2},
+				want:  3,
+			},
+			{
+				input: [2]float64{-7.5, 2},
+				want:  -4,
+			},
+			{
+				input: [2]float64{7.5, -2},
+				want:  -3,
+			},
+			{
+				input: [2]float64{-7.5, -2},
+				want:  4,
+			},
+			{
+				input: [2]float64{math.NaN(), 2},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := DivEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestModEuclid(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-7, 2},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ModEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{7, 2},
+				want:  1,
+			},
+			{
+				input: [2]int{-7, 2},
+				want:  1,
+			},
+			{
+				input: [2]int{7, -2},
+				want:  1,
+			},
+			{
+				input: [2]int{-7, -2},
+				want:  1,
+			},
+			{
+				input: [2]int{-8, 2},
+				want:  0,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  0,
+			},
+			{
+				input: [2]int{-1, math.MinInt},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  math.MaxInt - 1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ModEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{-1, -128},
+				want:  127,
+			},
+			{
+				input: [2]int8{-128, 127},
+				want:  126,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ModEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{255, 7},
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ModEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{7.5, 2},
+				want:  1.5,
+			},
+			{
+				input: [2]float32{-7.5, 2},
+				want:  0.5,
+			},
+			{
+				input: [2]float32{7.5, -2},
+				want:  1.5,
+			},
+			{
+				input: [2]float32{-7.5, -2},
+				want:  0.5,
+			},
+			{
+				input: [2]float32{-1e-30, 360},
+				want:  0,
+			},
+			{
+				input: [2]float32{negzero32(), 2},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{-1, Inf32(1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{1, Inf32(-1)},
+				want:  1,
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  float32(math.NaN()),
+			},
+			{
+				input: [2]float32{NaNWithPayload[float32](1), 2},
+				want:  NaNWithPayload[float32](1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ModEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  float64
+		}{
+			{
+				input: [2]float64{7.5, 2},
+				want:  1.5,
+			},
+			{
+				input: [2]float64{-7.5, 2},
+				want:  0.5,
+			},
+			{
+				input: [2]float64{-7.5, -2},
+				want:  0.5,
+			},
+			{
+				input: [2]float64{-0.25, 0.5},
+				want:  0.25,
+			},
+			{
+				input: [2]float64{-1e-300, 360},
+				want:  0,
+			},
+			{
+				input: [2]float64{math.Inf(1), 2},
+				want:  math.NaN(),
+			},
+			{
+				input: [2]float64{2, math.NaN()},
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := ModEuclid(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestModPanicsOnIntegerDivisionByZero(t *testing.T) {
+	tests := map[string]func(){
+		"Mod":       func() { Mod(1, 0) },
+		"Remainder": func() { Remainder(1, 0) },
+		"DivFloor":  func() { DivFloor(uint8(1), 0) },
+		"DivCeil":   func() { DivCeil(int64(1), 0) },
+		"DivEuclid": func() { DivEuclid(int8(1), 0) },
+		"ModEuclid": func() { ModEuclid(myInt(1), 0) },
+	}
+	for name, f := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("want panic")
+				}
+			}()
+			f()
+		})
+	}
+}
+
+// TestDivModExhaustive checks the integer division and remainder functions
+// against arithmetic in a wider type for every int8 and uint8 combination.
+func TestDivModExhaustive(t *testing.T) {
+	floorDiv := func(x, y int) int {
+		q := x / y
+		if x%y != 0 && (x < 0) != (y < 0) {
+			q--
+		}
+		return q
+	}
+	t.Run("int8", func(t *testing.T) {
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			for y := math.MinInt8; y <= math.MaxInt8; y++ {
+				if y == 0 {
+					continue
+				}
+				a, b := int8(x), int8(y)
+				fd := floorDiv(x, y)
+				cd := -floorDiv(-x, y)
+				ed, em := fd, x-fd*y
+				if em < 0 {
+					ed, em = cd, x-cd*y
+				}
+				n := int(math.RoundToEven(float64(x) / float64(y)))
+				assertCall(t, int8(x%y), Mod(a, b), "Mod", x, y)
+				assertCall(t, int8(x-n*y), Remainder(a, b), "Remainder", x, y)
+				assertCall(t, int8(fd), DivFloor(a, b), "DivFloor", x, y)
+				assertCall(t, int8(cd), DivCeil(a, b), "DivCeil", x, y)
+				assertCall(t, int8(ed), DivEuclid(a, b), "DivEuclid", x, y)
+				assertCall(t, int8(em), ModEuclid(a, b), "ModEuclid", x, y)
+			}
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		for x := 0; x <= math.MaxUint8; x++ {
+			for y := 1; y <= math.MaxUint8; y++ {
+				a, b := uint8(x), uint8(y)
+				assertCall(t, uint8(x%y), Mod(a, b), "Mod", x, y)
+				assertCall(t, uint8(x/y), DivFloor(a, b), "DivFloor", x, y)
+				assertCall(t, uint8((x+y-1)/y), DivCeil(a, b), "DivCeil", x, y)
+				assertCall(t, uint8(x/y), DivEuclid(a, b), "DivEuclid", x, y)
+				assertCall(t, uint8(x%y), ModEuclid(a, b), "ModEuclid", x, y)
+			}
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package gmath
+
+import "math"
+
+// NaN returns a quiet IEEE 754 “not-a-number” value of type T. It works for
+// any floating point type, including named types such as "type Celsius
+// float32".
+//
+// NaN returns the same bits as NaN32 for 32-bit floating point types and the
+// same bits as math.NaN for 64-bit floating point types. Use NaNWithPayload to
+// create a NaN carrying a payload.
+func NaN[T Float]() T {
+	return nan[T]()
+}
+
+// nan returns NaN[T]() for functions whose type parameter also allows integer
+// types. T must be a floating point type.
+func nan[T Integer | Float]() T {
+	if is32[T]() {
+		return T(math.Float32frombits(uvnan32))
+	}
+	return T(math.NaN())
+}
+
+// NaNWithPayload returns a quiet IEEE 754 “not-a-number” value of type T
+// carrying the given payload. The sign bit of the result is clear.
+//
+// Only the low 22 bits of payload are used for 32-bit floating point types and
+// only the low 51 bits are used for 64-bit floating point types. The remaining
+// bits are ignored.
+func NaNWithPayload[T Float](payload uint64) T {
+	if is32[T]() {
+		return T(math.Float32frombits(uvinf32 | quietBit32 | uint32(payload&payloadMask32)))
+	}
+	return T(math.Float64frombits(uvinf64 | quietBit64 | payload&payloadMask64))
+}
+
+// SignalingNaN returns a signaling IEEE 754 “not-a-number” value of type T
+// carrying the given payload. The sign bit of the result is clear.
+//
+// Only the low 22 bits of payload are used for 32-bit floating point types and
+// only the low 51 bits are used for 64-bit floating point types. The remaining
+// bits are ignored. A signaling NaN must have a non-zero payload, so a payload
+// of 0 (after ignoring the unused bits) is replaced with 1.
+//
+// Note that most floating point operations, including conversions between
+// float32 and float64, turn signaling NaNs into quiet NaNs. Functions in this
+// package return NaN inputs unchanged, so they don't quiet signaling NaNs.
+func SignalingNaN[T Float](payload uint64) T {
+	if is32[T]() {
+		p := uint32(payload & payloadMask32)
+		if p == 0 {
+			p = 1
+		}
+		return T(math.Float32frombits(uvinf32 | p))
+	}
+	p := payload & payloadMask64
+	if p == 0 {
+		p = 1
+	}
+	return T(math.Float64frombits(uvinf64 | p))
+}
+
+// NaNPayload returns the payload of x and true if x is a NaN. The payload does
+// not include the quiet bit, so quiet and signaling NaNs created with the same
+// payload report the same payload. If x is not a NaN, NaNPayload returns 0 and
+// false.
+func NaNPayload[T Float](x T) (payload uint64, ok bool) {
+	if !IsNaN(x) {
+		return 0, false
+	}
+	if is32[T]() {
+		return uint64(math.Float32bits(float32(x)) & payloadMask32), true
+	}
+	return math.Float64bits(float64(x)) & payloadMask64, true
+}
+
+// IsSignalingNaN reports whether x is a signaling IEEE 754 “not-a-number”
+// value.
+func IsSignalingNaN[T Float](x T) bool {
+	if !IsNaN(x) {
+		return false
+	}
+	if is32[T]() {
+		return math.Float32bits(float32(x))&quietBit32 == 0
+	}
+	return math.Float64bits(float64(x))&quietBit64 == 0
+}
+
+// Quiet returns x with the quiet bit set if x is a signaling NaN, preserving
+// the sign and payload of x. Otherwise, Quiet returns x unchanged.
+func Quiet[T Float](x T) T {
+	if !IsNaN(x) {
+		return x
+	}
+	if is32[T]() {
+		return T(math.Float32frombits(math.Float32bits(float32(x)) | quietBit32))
+	}
+	return T(math.Float64frombits(math.Float64bits(float64(x)) | quietBit64))
+}
@@ -0,0 +1,607 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNaN(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		assertEqual(t, uint32(0xFFC00000), math.Float32bits(NaN[float32]()))
+	})
+	t.Run("float64", func(t *testing.T) {
+		assertEqual(t, math.Float64bits(math.NaN()), math.Float64bits(NaN[float64]()))
+	})
+	t.Run("named type", func(t *testing.T) {
+		type celsius float32
+		assertEqual(t, uint32(0xFFC00000), math.Float32bits(float32(NaN[celsius]())))
+
+		type kelvin float64
+		assertEqual(t, math.Float64bits(math.NaN()), math.Float64bits(float64(NaN[kelvin]())))
+	})
+}
+
+func TestNaNWithPayload(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			payload uint64
+			want    float32
+		}{
+			{
+				payload: 0,
+				want:    math.Float32frombits(0x7FC00000),
+			},
+			{
+				payload: 0x1234,
+				want:    math.Float32frombits(0x7FC01234),
+			},
+			{
+				payload: 0x3FFFFF,
+				want:    math.Float32frombits(0x7FFFFFFF),
+			},
+			{
+				payload: 0x400001,
+				want:    math.Float32frombits(0x7FC00001),
+			},
+			{
+				payload: math.MaxUint64,
+				want:    math.Float32frombits(0x7FFFFFFF),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%#x", test.payload), func(t *testing.T) {
+				got := NaNWithPayload[float32](test.payload)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			payload uint64
+			want    float64
+		}{
+			{
+				payload: 0,
+				want:    math.Float64frombits(0x7FF8000000000000),
+			},
+			{
+				payload: 0x1234,
+				want:    math.Float64frombits(0x7FF8000000001234),
+			},
+			{
+				payload: 1<<51 - 1,
+				want:    math.Float64frombits(0x7FFFFFFFFFFFFFFF),
+			},
+			{
+				payload: 1<<51 + 1,
+				want:    math.Float64frombits(0x7FF8000000000001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%#x", test.payload), func(t *testing.T) {
+				got := NaNWithPayload[float64](test.payload)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("named type", func(t *testing.T) {
+		type celsius float32
+		got := NaNWithPayload[celsius](0x1234)
+		assertEqual(t, uint32(0x7FC01234), math.Float32bits(float32(got)))
+	})
+}
+
+func TestSignalingNaN(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			payload uint64
+			want    float32
+		}{
+			{
+				payload: 0,
+				want:    math.Float32frombits(0x7F800001),
+			},
+			{
+				payload: 0x1234,
+				want:    math.Float32frombits(0x7F801234),
+			},
+			{
+				payload: 0x3FFFFF,
+				want:    math.Float32frombits(0x7FBFFFFF),
+			},
+			{
+				payload: 0x400000,
+				want:    math.Float32frombits(0x7F800001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%#x", test.payload), func(t *testing.T) {
+				got := SignalingNaN[float32](test.payload)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			payload uint64
+			want    float64
+		}{
+			{
+				payload: 0,
+				want:    math.Float64frombits(0x7FF0000000000001),
+			},
+			{
+				payload: 0x1234,
+				want:    math.Float64frombits(0x7FF0000000001234),
+			},
+			{
+				payload: 1<<51 - 1,
+				want:    math.Float64frombits(0x7FF7FFFFFFFFFFFF),
+			},
+			{
+				payload: 1 << 51,
+				want:    math.Float64frombits(0x7FF0000000000001),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%#x", test.payload), func(t *testing.T) {
+				got := SignalingNaN[float64](test.payload)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestNaNPayload(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input  float32
+			want   uint64
+			wantOK bool
+		}{
+			{
+				input:  NaNWithPayload[float32](0x1234),
+				want:   0x1234,
+				wantOK: true,
+			},
+			{
+				input:  SignalingNaN[float32](0x1234),
+				want:   0x1234,
+				wantOK: true,
+			},
+			{
+				input:  -NaNWithPayload[float32](0x3FFFFF),
+				want:   0x3FFFFF,
+				wantOK: true,
+			},
+			{
+				input:  NaN32(),
+				want:   0,
+				wantOK: true,
+			},
+			{
+				input:  Inf32(1),
+				want:   0,
+				wantOK: false,
+			},
+			{
+				input:  1.5,
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NaNPayload(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input  float64
+			want   uint64
+			wantOK bool
+		}{
+			{
+				input:  NaNWithPayload[float64](0x1234),
+				want:   0x1234,
+				wantOK: true,
+			},
+			{
+				input:  SignalingNaN[float64](0x1234),
+				want:   0x1234,
+				wantOK: true,
+			},
+			{
+				input:  NaNWithPayload[float64](1<<51 - 1),
+				want:   1<<51 - 1,
+				wantOK: true,
+			},
+			{
+				input:  math.NaN(),
+				want:   1,
+				wantOK: true,
+			},
+			{
+				input:  math.Inf(-1),
+				want:   0,
+				wantOK: false,
+			},
+			{
+				input:  1.5,
+				want:   0,
+				wantOK: false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got, ok := NaNPayload(test.input)
+				assertEqual(t, test.want, got)
+				assertEqual(t, test.wantOK, ok)
+			})
+		}
+	})
+}
+
+func TestIsSignalingNaN(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  bool
+		}{
+			{
+				input: SignalingNaN[float32](0x1234),
+				want:  true,
+			},
+			{
+				input: -SignalingNaN[float32](1),
+				want:  true,
+			},
+			{
+				input: NaNWithPayload[float32](0x1234),
+				want:  false,
+			},
+			{
+				input: NaN32(),
+				want:  false,
+			},
+			{
+				input: Inf32(1),
+				want:  false,
+			},
+			{
+				input: 0,
+				want:  false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := IsSignalingNaN(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+			want  bool
+		}{
+			{
+				input: SignalingNaN[float64](0x1234),
+				want:  true,
+			},
+			{
+				input: NaNWithPayload[float64](0x1234),
+				want:  false,
+			},
+			{
+				input: math.NaN(),
+				want:  false,
+			},
+			{
+				input: math.Inf(1),
+				want:  false,
+			},
+			{
+				input: 0,
+				want:  false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := IsSignalingNaN(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestQuiet(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: SignalingNaN[float32](0x1234),
+				want:  NaNWithPayload[float32](0x1234),
+			},
+			{
+				input: math.Float32frombits(0xFF801234),
+				want:  math.Float32frombits(0xFFC01234),
+			},
+			{
+				input: NaNWithPayload[float32](0x1234),
+				want:  NaNWithPayload[float32](0x1234),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(-1),
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 1.5,
+				want:  1.5,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Quiet(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+			want  float64
+		}{
+			{
+				input: SignalingNaN[float64](0x1234),
+				want:  NaNWithPayload[float64](0x1234),
+			},
+			{
+				input: math.Float64frombits(0xFFF0000000001234),
+				want:  math.Float64frombits(0xFFF8000000001234),
+			},
+			{
+				input: math.NaN(),
+				want:  math.NaN(),
+			},
+			{
+				input: math.Inf(1),
+				want:  math.Inf(1),
+			},
+			{
+				input: negzero64(),
+				want:  negzero64(),
+			},
+			{
+				input: 1.5,
+				want:  1.5,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Quiet(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+// TestNaNPayloadAudit checks that every function that propagates NaN inputs
+// returns them with the sign, payload and quiet bit unchanged.
+func TestNaNPayloadAudit(t *testing.T) {
+	funcs := []struct {
+		name string
+		f32  func(float32) float32
+		f64  func(float64) float64
+	}{
+		{"Exp", Exp[float32], Exp[float64]},
+		{"Exp2", Exp2[float32], Exp2[float64]},
+		{"Expm1", Expm1[float32], Expm1[float64]},
+		{
+			name: "Pow x",
+			f32:  func(x float32) float32 { return Pow(x, 2.5) },
+			f64:  func(x float64) float64 { return Pow(x, 2.5) },
+		},
+		{
+			name: "Pow y",
+			f32:  func(x float32) float32 { return Pow(float32(2), x) },
+			f64:  func(x float64) float64 { return Pow(2.0, x) },
+		},
+		{"Floor", Floor[float32], Floor[float64]},
+		{"Ceil", Ceil[float32], Ceil[float64]},
+		{"Trunc", Trunc[float32], Trunc[float64]},
+		{"Round", Round[float32], Round[float64]},
+		{"RoundToEven", RoundToEven[float32], RoundToEven[float64]},
+		{
+			name: "Frexp",
+			f32:  func(x float32) float32 { f, _ := Frexp(x); return f },
+			f64:  func(x float64) float64 { f, _ := Frexp(x); return f },
+		},
+		{
+			name: "Ldexp",
+			f32:  func(x float32) float32 { return Ldexp(x, 3) },
+			f64:  func(x float64) float64 { return Ldexp(x, 3) },
+		},
+		{
+			name: "Scalbn",
+			f32:  func(x float32) float32 { return Scalbn(x, 3) },
+			f64:  func(x float64) float64 { return Scalbn(x, 3) },
+		},
+		{
+			name: "Modf i",
+			f32:  func(x float32) float32 { f, _ := Modf(x); return f },
+			f64:  func(x float64) float64 { f, _ := Modf(x); return f },
+		},
+		{
+			name: "Modf f",
+			f32:  func(x float32) float32 { _, f := Modf(x); return f },
+			f64:  func(x float64) float64 { _, f := Modf(x); return f },
+		},
+		{
+			name: "Nextafter x",
+			f32:  func(x float32) float32 { return Nextafter(x, 1) },
+			f64:  func(x float64) float64 { return Nextafter(x, 1) },
+		},
+		{
+			name: "Nextafter y",
+			f32:  func(x float32) float32 { return Nextafter(1, x) },
+			f64:  func(x float64) float64 { return Nextafter(1, x) },
+		},
+		{"Abs", Abs[float32], Abs[float64]},
+		{
+			name: "Copysign x",
+			f32:  func(x float32) float32 { return Copysign(x, 1) },
+			f64:  func(x float64) float64 { return Copysign(x, 1) },
+		},
+		{
+			name: "Dim x",
+			f32:  func(x float32) float32 { return Dim(x, 1) },
+			f64:  func(x float64) float64 { return Dim(x, 1) },
+		},
+		{
+			name: "Dim y",
+			f32:  func(x float32) float32 { return Dim(1, x) },
+			f64:  func(x float64) float64 { return Dim(1, x) },
+		},
+		{"Logb", Logb[float32], Logb[float64]},
+		{
+			name: "Max x",
+			f32:  func(x float32) float32 { return Max(x, 1) },
+			f64:  func(x float64) float64 { return Max(x, 1) },
+		},
+		{
+			name: "Max y",
+			f32:  func(x float32) float32 { return Max(1, x) },
+			f64:  func(x float64) float64 { return Max(1, x) },
+		},
+		{
+			name: "Min x",
+			f32:  func(x float32) float32 { return Min(x, 1) },
+			f64:  func(x float64) float64 { return Min(x, 1) },
+		},
+		{
+			name: "Min y",
+			f32:  func(x float32) float32 { return Min(1, x) },
+			f64:  func(x float64) float64 { return Min(1, x) },
+		},
+		{"Sinh", Sinh[float32], Sinh[float64]},
+		{"Cosh", Cosh[float32], Cosh[float64]},
+		{"Tanh", Tanh[float32], Tanh[float64]},
+		{"Asinh", Asinh[float32], Asinh[float64]},
+		{"Acosh", Acosh[float32], Acosh[float64]},
+		{"Atanh", Atanh[float32], Atanh[float64]},
+		{
+			name: "RoundTo",
+			f32:  func(x float32) float32 { return RoundTo(x, 2, ToNearestEven) },
+			f64:  func(x float64) float64 { return RoundTo(x, 2, ToNearestEven) },
+		},
+		{
+			name: "RoundToMultiple x",
+			f32:  func(x float32) float32 { return RoundToMultiple(x, 2, ToNearestEven) },
+			f64:  func(x float64) float64 { return RoundToMultiple(x, 2, ToNearestEven) },
+		},
+		{
+			name: "RoundToMultiple m",
+			f32:  func(x float32) float32 { return RoundToMultiple(3, x, ToNearestEven) },
+			f64:  func(x float64) float64 { return RoundToMultiple(3, x, ToNearestEven) },
+		},
+		{"Sqrt", Sqrt[float32], Sqrt[float64]},
+		{"Cbrt", Cbrt[float32], Cbrt[float64]},
+		{
+			name: "Hypot p",
+			f32:  func(x float32) float32 { return Hypot(x, 1) },
+			f64:  func(x float64) float64 { return Hypot(x, 1) },
+		},
+		{
+			name: "Hypot q",
+			f32:  func(x float32) float32 { return Hypot(1, x) },
+			f64:  func(x float64) float64 { return Hypot(1, x) },
+		},
+		{"RSqrt", RSqrt[float32], RSqrt[float64]},
+		{"Sin", Sin[float32], Sin[float64]},
+		{"Cos", Cos[float32], Cos[float64]},
+		{"Tan", Tan[float32], Tan[float64]},
+		{
+			name: "Sincos s",
+			f32:  func(x float32) float32 { s, _ := Sincos(x); return s },
+			f64:  func(x float64) float64 { s, _ := Sincos(x); return s },
+		},
+		{
+			name: "Sincos c",
+			f32:  func(x float32) float32 { _, c := Sincos(x); return c },
+			f64:  func(x float64) float64 { _, c := Sincos(x); return c },
+		},
+		{"Asin", Asin[float32], Asin[float64]},
+		{"Acos", Acos[float32], Acos[float64]},
+		{"Atan", Atan[float32], Atan[float64]},
+		{
+			name: "Atan2 y",
+			f32:  func(x float32) float32 { return Atan2(x, 1) },
+			f64:  func(x float64) float64 { return Atan2(x, 1) },
+		},
+		{
+			name: "Atan2 x",
+			f32:  func(x float32) float32 { return Atan2(float32(1), x) },
+			f64:  func(x float64) float64 { return Atan2(1.0, x) },
+		},
+	}
+	nan32s := []float32{
+		NaNWithPayload[float32](0x1234),
+		SignalingNaN[float32](0x1234),
+		math.Float32frombits(0xFFC01234),
+		math.Float32frombits(0xFF801234),
+	}
+	nan64s := []float64{
+		NaNWithPayload[float64](0x1234),
+		SignalingNaN[float64](0x1234),
+		math.Float64frombits(0xFFF8000000001234),
+		math.Float64frombits(0xFFF0000000001234),
+	}
+	for _, fn := range funcs {
+		t.Run(fn.name, func(t *testing.T) {
+			for _, x := range nan32s {
+				assertEqual(t, x, fn.f32(x))
+			}
+			for _, x := range nan64s {
+				assertEqual(t, x, fn.f64(x))
+			}
+		})
+	}
+
+	// The Log functions always return a float64, so float32 NaNs are
+	// converted the same way as float64(x).
+	logs := []struct {
+		name string
+		f32  func(float32) float64
+		f64  func(float64) float64
+	}{
+		{"Log", Log[float32], Log[float64]},
+		{"Log10", Log10[float32], Log10[float64]},
+		{"Log1p", Log1p[float32], Log1p[float64]},
+		{"Log2", Log2[float32], Log2[float64]},
+	}
+	for _, fn := range logs {
+		t.Run(fn.name, func(t *testing.T) {
+			for _, x := range nan32s {
+				assertEqual(t, float64(x), fn.f32(x))
+			}
+			for _, x := range nan64s {
+				assertEqual(t, x, fn.f64(x))
+			}
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package gmath
+
+import (
+	"math/big"
+	"strconv"
+)
+
+// A RoundingMode determines how RoundTo and RoundToMultiple round a value that
+// lies between two candidate results.
+type RoundingMode byte
+
+// These constants define the supported rounding modes.
+const (
+	ToNearestEven RoundingMode = iota // round to nearest, ties to even
+	ToNearestAway                     // round to nearest, ties away from zero
+	ToZero                            // round toward zero
+	AwayFromZero                      // round away from zero
+	ToNegativeInf                     // round toward negative infinity (floor)
+	ToPositiveInf                     // round toward positive infinity (ceil)
+)
+
+// maxDecimalPlaces bounds the number of decimal places RoundTo considers. The
+// shortest decimal representation of any float64 has fewer than 400 digits
+// after the decimal point, and rounding any float64 to a multiple of 10**400
+// gives either zero or an infinity.
+const maxDecimalPlaces = 400
+
+// RoundTo returns x rounded to the given number of decimal places using the
+// given rounding mode. Negative values of places round to the left of the
+// decimal point, so RoundTo(1234, -2, ToNearestEven) = 1200.
+//
+// For floating point types, RoundTo rounds the shortest decimal representation
+// of x (the one printed by strconv.FormatFloat with precision -1) rather than
+// its exact binary value, so RoundTo(2.675, 2, ToNearestAway) = 2.68 even though
+// the float64 nearest to 2.675 is slightly smaller than 2.675. The result is
+// the float nearest to the rounded decimal value.
+//
+// Special cases are:
+//
+//	RoundTo(±0, places, mode) = ±0
+//	RoundTo(±Inf, places, mode) = ±Inf
+//	RoundTo(NaN, places, mode) = NaN
+//
+// A floating point result that rounds to zero has the sign of x. A floating
+// point result too large to represent is ±Inf.
+//
+// For integer types, RoundTo returns x if places >= 0. If the rounded result
+// would overflow T, RoundTo returns the candidate result nearer to zero
+// instead.
+func RoundTo[T Integer | Float](x T, places int, mode RoundingMode) T {
+	if !isFloat[T]() {
+		if places >= 0 {
+			return x
+		}
+		// 10**20 is larger than any 64-bit integer, so every value rounds to
+		// zero or to a multiple that overflows T, and is replaced by zero.
+		if places < -19 {
+			return 0
+		}
+		m := uint64(1)
+		for i := 0; i < -places; i++ {
+			m *= 10
+		}
+		return roundIntToMultiple(x, m, mode)
+	}
+
+	if x == 0 || IsNaN(x) || IsInf(x, 0) {
+		return x
+	}
+	if places > maxDecimalPlaces {
+		places = maxDecimalPlaces
+	}
+	if places < -maxDecimalPlaces {
+		places = -maxDecimalPlaces
+	}
+	n := places
+	if n < 0 {
+		n = -n
+	}
+	m := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil))
+	if places > 0 {
+		m.Inv(m)
+	}
+	return roundFloatToMultiple(x, m, mode)
+}
+
+// RoundToMultiple returns x rounded to a multiple of m using the given
+// rounding mode. The sign of m is ignored.
+//
+// For floating point types, RoundToMultiple uses the shortest decimal
+// representations of x and m (the ones printed by strconv.FormatFloat with
+// precision -1) rather than their exact binary values, so
+// RoundToMultiple(1.025, 0.05, ToNearestAway) = 1.05. The result is the float
+// nearest to the rounded decimal value.
+//
+// Special cases are:
+//
+//	RoundToMultiple(x, ±0, mode) = x
+//	RoundToMultiple(±0, m, mode) = ±0
+//	RoundToMultiple(±Inf, m, mode) = ±Inf for finite m
+//	RoundToMultiple(x, ±Inf, mode) = NaN
+//	RoundToMultiple(NaN, m, mode) = NaN
+//	RoundToMultiple(x, NaN, mode) = NaN
+//
+// A floating point result that rounds to zero has the sign of x. A floating
+// point result too large to represent is ±Inf.
+//
+// For integer types, if the rounded result would overflow T, RoundToMultiple
+// returns the candidate result nearer to zero instead.
+func RoundToMultiple[T Integer | Float](x, m T, mode RoundingMode) T {
+	if !isFloat[T]() {
+		if m == 0 {
+			return x
+		}
+		um := uint64(m)
+		if m < 0 {
+			um = -um
+		}
+		return roundIntToMultiple(x, um, mode)
+	}
+
+	// special cases
+	switch {
+	case IsNaN(x):
+		return x
+	case IsNaN(m):
+		return m
+	case IsInf(m, 0):
+		return nan[T]()
+	case m == 0 || x == 0 || IsInf(x, 0):
+		return x
+	}
+	if m < 0 {
+		m = -m
+	}
+	return roundFloatToMultiple(x, decimalRat(m), mode)
+}
+
+// roundIntToMultiple returns the integer x rounded to a multiple of m > 0. If
+// the rounded result would overflow T, the candidate nearer zero is returned.
+func roundIntToMultiple[T Integer | Float](x T, m uint64, mode RoundingMode) T {
+	// Work with the magnitude of x, which is representable as a uint64 even
+	// for the minimum value of a signed type.
+	neg := x < 0
+	ux := magnitude(x)
+
+	q, r := ux/m, ux%m
+	if r == 0 {
+		return x
+	}
+	half := 0
+	switch {
+	case r < m-r:
+		half = -1
+	case r > m-r:
+		half = 1
+	}
+	down := q * m
+	if roundAway(neg, q&1 == 1, half, mode) {
+		// The magnitude limit of T is one larger for negative values.
+		limit := uint64(MaxValue[T]())
+		if neg {
+			limit++
+		}
+		if up := down + m; up > down && up <= limit {
+			down = up
+		}
+	}
+	if neg {
+		return T(-down)
+	}
+	return T(down)
+}
+
+// roundAway reports whether a value strictly between two candidate results
+// should be rounded to the candidate farther from zero. The value is negative
+// if neg is true, the candidate nearer zero is odd if odd is true, and half is
+// -1, 0 or +1 depending on whether the value is nearer to, halfway between or
+// farther from the candidate nearer zero.
+func roundAway(neg, odd bool, half int, mode RoundingMode) bool {
+	switch mode {
+	case ToNearestEven:
+		return half > 0 || half == 0 && odd
+	case ToNearestAway:
+		return half >= 0
+	case AwayFromZero:
+		return true
+	case ToNegativeInf:
+		return neg
+	case ToPositiveInf:
+		return !neg
+	}
+	return false
+}
+
+// roundFloatToMultiple returns the finite, nonzero float x rounded to a
+// multiple of m > 0, using the shortest decimal representation of x.
+func roundFloatToMultiple[T Integer | Float](x T, m *big.Rat, mode RoundingMode) T {
+	q := new(big.Rat).Quo(decimalRat(x), m)
+	// Split q into its integer part (truncated toward zero) and a remainder,
+	// then compare the remainder against half of the denominator.
+	num, den := q.Num(), q.Denom()
+	n, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() != 0 {
+		r.Abs(r)
+		half := r.Cmp(new(big.Int).Sub(den, r))
+		if roundAway(x < 0, n.Bit(0) == 1, half, mode) {
+			n.Add(n, big.NewInt(int64(num.Sign())))
+		}
+	}
+	v := ratToFloat[T](q.SetInt(n).Mul(q, m))
+	if v == 0 && x < 0 {
+		// Rounding a negative value to zero gives -0.
+		return -v
+	}
+	return v
+}
+
+// decimalRat returns the exact value of the shortest decimal representation of
+// the finite float x.
+func decimalRat[T Integer | Float](x T) *big.Rat {
+	bitSize := 64
+	if is32[T]() {
+		bitSize = 32
+	}
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(float64(x), 'g', -1, bitSize))
+	return r
+}
+
+// ratToFloat returns the float of type T nearest to r.
+func ratToFloat[T Integer | Float](r *big.Rat) T {
+	if is32[T]() {
+		f, _ := r.Float32()
+		return T(f)
+	}
+	f, _ := r.Float64()
+	return T(f)
+}
@@ -0,0 +1,670 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestRoundTo(t *testing.T) {
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			x      float64
+			places int
+			mode   RoundingMode
+			want   float64
+		}{
+			{
+				x:      2.675,
+				places: 2,
+				mode:   ToNearestEven,
+				want:   2.68,
+			},
+			{
+				x:      2.675,
+				places: 2,
+				mode:   ToNearestAway,
+				want:   2.68,
+			},
+			{
+				x:      2.675,
+				places: 2,
+				mode:   ToZero,
+				want:   2.67,
+			},
+			{
+				x:      2.665,
+				places: 2,
+				mode:   ToNearestEven,
+				want:   2.66,
+			},
+			{
+				x:      2.665,
+				places: 2,
+				mode:   ToNearestAway,
+				want:   2.67,
+			},
+			{
+				x:      -2.675,
+				places: 2,
+				mode:   ToNearestEven,
+				want:   -2.68,
+			},
+			{
+				x:      -2.675,
+				places: 2,
+				mode:   ToNegativeInf,
+				want:   -2.68,
+			},
+			{
+				x:      -2.675,
+				places: 2,
+				mode:   ToPositiveInf,
+				want:   -2.67,
+			},
+			{
+				x:      2.671,
+				places: 2,
+				mode:   AwayFromZero,
+				want:   2.68,
+			},
+			{
+				x:      2.679,
+				places: 2,
+				mode:   ToZero,
+				want:   2.67,
+			},
+			{
+				x:      1.005,
+				places: 2,
+				mode:   ToNearestAway,
+				want:   1.01,
+			},
+			{
+				x:      1234.5,
+				places: -2,
+				mode:   ToNearestEven,
+				want:   1200,
+			},
+			{
+				x:      1250,
+				places: -2,
+				mode:   ToNearestEven,
+				want:   1200,
+			},
+			{
+				x:      1350,
+				places: -2,
+				mode:   ToNearestEven,
+				want:   1400,
+			},
+			{
+				x:      0.1,
+				places: 0,
+				mode:   ToPositiveInf,
+				want:   1,
+			},
+			{
+				x:      -0.001,
+				places: 2,
+				mode:   ToNearestEven,
+				want:   negzero64(),
+			},
+			{
+				x:      -0.001,
+				places: 2,
+				mode:   ToNegativeInf,
+				want:   -0.01,
+			},
+			{
+				x:      1e308,
+				places: -308,
+				mode:   AwayFromZero,
+				want:   1e308,
+			},
+			{
+				x:      1e308,
+				places: -309,
+				mode:   AwayFromZero,
+				want:   math.Inf(1),
+			},
+			{
+				x:      5e-324,
+				places: 1000,
+				mode:   ToNearestEven,
+				want:   5e-324,
+			},
+			{
+				x:      negzero64(),
+				places: 2,
+				mode:   ToNearestEven,
+				want:   negzero64(),
+			},
+			{
+				x:      math.Inf(-1),
+				places: 2,
+				mode:   ToNearestEven,
+				want:   math.Inf(-1),
+			},
+			{
+				x:      math.NaN(),
+				places: 2,
+				mode:   ToNearestEven,
+				want:   math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.places, test.mode), func(t *testing.T) {
+				got := RoundTo(test.x, test.places, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			x      float32
+			places int
+			mode   RoundingMode
+			want   float32
+		}{
+			{
+				x:      2.675,
+				places: 2,
+				mode:   ToNearestEven,
+				want:   2.68,
+			},
+			{
+				x:      0.125,
+				places: 2,
+				mode:   ToNearestEven,
+				want:   0.12,
+			},
+			{
+				x:      0.125,
+				places: 2,
+				mode:   ToNearestAway,
+				want:   0.13,
+			},
+			{
+				x:      -0.001,
+				places: 1,
+				mode:   ToNearestEven,
+				want:   negzero32(),
+			},
+			{
+				x:      3.4e38,
+				places: -38,
+				mode:   AwayFromZero,
+				want:   Inf32(1),
+			},
+			{
+				x:      NaN32(),
+				places: 2,
+				mode:   ToNearestEven,
+				want:   NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.places, test.mode), func(t *testing.T) {
+				got := RoundTo(test.x, test.places, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			x      myInt
+			places int
+			mode   RoundingMode
+			want   myInt
+		}{
+			{
+				x:      15,
+				places: -1,
+				mode:   ToNearestEven,
+				want:   20,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.places, test.mode), func(t *testing.T) {
+				got := RoundTo(test.x, test.places, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			x      int64
+			places int
+			mode   RoundingMode
+			want   int64
+		}{
+			{
+				x:      1250,
+				places: -2,
+				mode:   ToNearestEven,
+				want:   1200,
+			},
+			{
+				x:      1250,
+				places: -2,
+				mode:   ToNearestAway,
+				want:   1300,
+			},
+			{
+				x:      -1250,
+				places: -2,
+				mode:   ToNearestAway,
+				want:   -1300,
+			},
+			{
+				x:      -1251,
+				places: -2,
+				mode:   ToZero,
+				want:   -1200,
+			},
+			{
+				x:      -1201,
+				places: -2,
+				mode:   ToNegativeInf,
+				want:   -1300,
+			},
+			{
+				x:      1201,
+				places: -2,
+				mode:   ToPositiveInf,
+				want:   1300,
+			},
+			{
+				x:      1234,
+				places: 2,
+				mode:   AwayFromZero,
+				want:   1234,
+			},
+			{
+				x:      math.MaxInt64,
+				places: -1,
+				mode:   ToPositiveInf,
+				want:   math.MaxInt64 - 7,
+			},
+			{
+				x:      math.MinInt64,
+				places: -1,
+				mode:   ToNearestAway,
+				want:   math.MinInt64 + 8,
+			},
+			{
+				x:      math.MaxInt64,
+				places: -20,
+				mode:   AwayFromZero,
+				want:   0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.places, test.mode), func(t *testing.T) {
+				got := RoundTo(test.x, test.places, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			x      int8
+			places int
+			mode   RoundingMode
+			want   int8
+		}{
+			{
+				x:      127,
+				places: -1,
+				mode:   ToPositiveInf,
+				want:   120,
+			},
+			{
+				x:      -128,
+				places: -1,
+				mode:   ToNearestAway,
+				want:   -120,
+			},
+			{
+				x:      -125,
+				places: -1,
+				mode:   ToNearestEven,
+				want:   -120,
+			},
+			{
+				x:      -126,
+				places: -1,
+				mode:   ToNearestEven,
+				want:   -120,
+			},
+			{
+				x:      125,
+				places: -2,
+				mode:   ToNearestEven,
+				want:   100,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.places, test.mode), func(t *testing.T) {
+				got := RoundTo(test.x, test.places, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			x      uint8
+			places int
+			mode   RoundingMode
+			want   uint8
+		}{
+			{
+				x:      255,
+				places: -1,
+				mode:   ToNearestEven,
+				want:   250,
+			},
+			{
+				x:      250,
+				places: -2,
+				mode:   ToNearestAway,
+				want:   200,
+			},
+			{
+				x:      150,
+				places: -2,
+				mode:   ToNearestEven,
+				want:   200,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.places, test.mode), func(t *testing.T) {
+				got := RoundTo(test.x, test.places, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestRoundToMultiple(t *testing.T) {
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			x    float64
+			m    float64
+			mode RoundingMode
+			want float64
+		}{
+			{
+				x:    1.025,
+				m:    0.05,
+				mode: ToNearestEven,
+				want: 1,
+			},
+			{
+				x:    1.025,
+				m:    0.05,
+				mode: ToNearestAway,
+				want: 1.05,
+			},
+			{
+				x:    1.07,
+				m:    0.05,
+				mode: ToNearestEven,
+				want: 1.05,
+			},
+			{
+				x:    1.07,
+				m:    -0.05,
+				mode: ToPositiveInf,
+				want: 1.1,
+			},
+			{
+				x:    -1.07,
+				m:    0.05,
+				mode: ToNegativeInf,
+				want: -1.1,
+			},
+			{
+				x:    -1.07,
+				m:    0.05,
+				mode: ToZero,
+				want: -1.05,
+			},
+			{
+				x:    0.3,
+				m:    0.1,
+				mode: ToNearestEven,
+				want: 0.3,
+			},
+			{
+				x:    7.5,
+				m:    2.5,
+				mode: ToNearestEven,
+				want: 7.5,
+			},
+			{
+				x:    8.75,
+				m:    2.5,
+				mode: ToNearestEven,
+				want: 10,
+			},
+			{
+				x:    -0.01,
+				m:    0.05,
+				mode: ToNearestEven,
+				want: negzero64(),
+			},
+			{
+				x:    1,
+				m:    0,
+				mode: ToNearestEven,
+				want: 1,
+			},
+			{
+				x:    1,
+				m:    math.Inf(1),
+				mode: ToNearestEven,
+				want: NaN[float64](),
+			},
+			{
+				x:    math.Inf(1),
+				m:    2,
+				mode: ToNearestEven,
+				want: math.Inf(1),
+			},
+			{
+				x:    math.NaN(),
+				m:    2,
+				mode: ToNearestEven,
+				want: math.NaN(),
+			},
+			{
+				x:    2,
+				m:    math.NaN(),
+				mode: ToNearestEven,
+				want: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.m, test.mode), func(t *testing.T) {
+				got := RoundToMultiple(test.x, test.m, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			x    float32
+			m    float32
+			mode RoundingMode
+			want float32
+		}{
+			{
+				x:    1.025,
+				m:    0.05,
+				mode: ToNearestAway,
+				want: 1.05,
+			},
+			{
+				x:    0.7,
+				m:    0.25,
+				mode: ToNearestEven,
+				want: 0.75,
+			},
+			{
+				x:    1,
+				m:    Inf32(-1),
+				mode: ToNearestEven,
+				want: NaN[float32](),
+			},
+			{
+				x:    NaN32(),
+				m:    1,
+				mode: ToNearestEven,
+				want: NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.m, test.mode), func(t *testing.T) {
+				got := RoundToMultiple(test.x, test.m, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			x    myInt
+			m    myInt
+			mode RoundingMode
+			want myInt
+		}{
+			{
+				x:    37,
+				m:    15,
+				mode: ToNearestEven,
+				want: 30,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.m, test.mode), func(t *testing.T) {
+				got := RoundToMultiple(test.x, test.m, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			x    int
+			m    int
+			mode RoundingMode
+			want int
+		}{
+			{
+				x:    37,
+				m:    15,
+				mode: ToNearestEven,
+				want: 30,
+			},
+			{
+				x:    37,
+				m:    15,
+				mode: AwayFromZero,
+				want: 45,
+			},
+			{
+				x:    -37,
+				m:    15,
+				mode: ToNegativeInf,
+				want: -45,
+			},
+			{
+				x:    -37,
+				m:    -15,
+				mode: ToPositiveInf,
+				want: -30,
+			},
+			{
+				x:    45,
+				m:    30,
+				mode: ToNearestEven,
+				want: 60,
+			},
+			{
+				x:    75,
+				m:    30,
+				mode: ToNearestEven,
+				want: 60,
+			},
+			{
+				x:    75,
+				m:    30,
+				mode: ToNearestAway,
+				want: 90,
+			},
+			{
+				x:    7,
+				m:    0,
+				mode: ToNearestEven,
+				want: 7,
+			},
+			{
+				x:    math.MaxInt64,
+				m:    math.MaxInt64,
+				mode: ToNearestEven,
+				want: math.MaxInt64,
+			},
+			{
+				x:    math.MinInt64,
+				m:    3,
+				mode: ToNearestEven,
+				want: math.MinInt64 + 2,
+			},
+			{
+				x:    math.MinInt64,
+				m:    math.MinInt64,
+				mode: ToNearestEven,
+				want: math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.m, test.mode), func(t *testing.T) {
+				got := RoundToMultiple(test.x, test.m, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			x    uint64
+			m    uint64
+			mode RoundingMode
+			want uint64
+		}{
+			{
+				x:    math.MaxUint64,
+				m:    10,
+				mode: ToPositiveInf,
+				want: math.MaxUint64 - 5,
+			},
+			{
+				x:    14,
+				m:    4,
+				mode: ToNearestEven,
+				want: 16,
+			},
+			{
+				x:    10,
+				m:    4,
+				mode: ToNearestEven,
+				want: 8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.x, test.m, test.mode), func(t *testing.T) {
+				got := RoundToMultiple(test.x, test.m, test.mode)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
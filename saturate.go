@@ -0,0 +1,80 @@
+package gmath
+
+// AddSat returns x+y, saturating at the minimum and maximum values of T instead
+// of wrapping around. For example:
+//
+//	AddSat(int8(100), int8(100)) = math.MaxInt8
+//	AddSat(int8(-100), int8(-100)) = math.MinInt8
+//	AddSat(uint8(200), uint8(100)) = math.MaxUint8
+func AddSat[T Integer](x, y T) T {
+	if s, ok := AddChecked(x, y); ok {
+		return s
+	}
+	if y < 0 {
+		return MinValue[T]()
+	}
+	return MaxValue[T]()
+}
+
+// SubSat returns x-y, saturating at the minimum and maximum values of T instead
+// of wrapping around. For example:
+//
+//	SubSat(int8(-100), int8(100)) = math.MinInt8
+//	SubSat(int8(100), int8(-100)) = math.MaxInt8
+//	SubSat(uint8(1), uint8(2)) = 0
+func SubSat[T Integer](x, y T) T {
+	if d, ok := SubChecked(x, y); ok {
+		return d
+	}
+	if y < 0 {
+		return MaxValue[T]()
+	}
+	return MinValue[T]()
+}
+
+// MulSat returns x*y, saturating at the minimum and maximum values of T instead
+// of wrapping around. For example:
+//
+//	MulSat(int8(64), int8(2)) = math.MaxInt8
+//	MulSat(int8(64), int8(-4)) = math.MinInt8
+//	MulSat(int8(math.MinInt8), int8(-1)) = math.MaxInt8
+//	MulSat(uint8(16), uint8(16)) = math.MaxUint8
+func MulSat[T Integer](x, y T) T {
+	if p, ok := MulChecked(x, y); ok {
+		return p
+	}
+	if (x < 0) != (y < 0) {
+		return MinValue[T]()
+	}
+	return MaxValue[T]()
+}
+
+// NegSat returns -x, saturating at the minimum and maximum values of T instead
+// of wrapping around. For example:
+//
+//	NegSat(int8(math.MinInt8)) = math.MaxInt8
+//	NegSat(uint8(1)) = 0
+func NegSat[T Integer](x T) T {
+	if n, ok := NegChecked(x); ok {
+		return n
+	}
+	// Only the minimum value of a signed integer type or a non-zero value of an
+	// unsigned integer type overflows.
+	if x < 0 {
+		return MaxValue[T]()
+	}
+	return 0
+}
+
+// AbsSat returns the absolute value of x, saturating at the maximum value of T
+// instead of wrapping around. Unlike Abs, AbsSat never returns a negative
+// value. For unsigned integer types, AbsSat returns x. For example:
+//
+//	AbsSat(int8(math.MinInt8)) = math.MaxInt8
+//	AbsSat(int64(math.MinInt64)) = math.MaxInt64
+func AbsSat[T Integer](x T) T {
+	if x >= 0 {
+		return x
+	}
+	return NegSat(x)
+}
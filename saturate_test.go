@@ -0,0 +1,1223 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAddSat(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{1, 2},
+				want:  3,
+			},
+			{
+				input: [2]myInt{math.MaxInt, 1},
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{math.MaxInt, math.MaxInt},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{math.MinInt, math.MinInt},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MinInt, math.MaxInt},
+				want:  -1,
+			},
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{100, 27},
+				want:  127,
+			},
+			{
+				input: [2]int8{100, 100},
+				want:  math.MaxInt8,
+			},
+			{
+				input: [2]int8{-100, -100},
+				want:  math.MinInt8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input [2]int16
+			want  int16
+		}{
+			{
+				input: [2]int16{math.MaxInt16, 1},
+				want:  math.MaxInt16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input [2]int32
+			want  int32
+		}{
+			{
+				input: [2]int32{math.MinInt32, -1},
+				want:  math.MinInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input [2]int64
+			want  int64
+		}{
+			{
+				input: [2]int64{math.MaxInt64, 1},
+				want:  math.MaxInt64,
+			},
+			{
+				input: [2]int64{math.MinInt64, -1},
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint
+			want  uint
+		}{
+			{
+				input: [2]uint{math.MaxUint, 1},
+				want:  math.MaxUint,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{200, 55},
+				want:  255,
+			},
+			{
+				input: [2]uint8{200, 100},
+				want:  math.MaxUint8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint16
+			want  uint16
+		}{
+			{
+				input: [2]uint16{math.MaxUint16, 1},
+				want:  math.MaxUint16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint32
+			want  uint32
+		}{
+			{
+				input: [2]uint32{math.MaxUint32, 1},
+				want:  math.MaxUint32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  uint64
+		}{
+			{
+				input: [2]uint64{math.MaxUint64, math.MaxUint64},
+				want:  math.MaxUint64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input [2]uintptr
+			want  uintptr
+		}{
+			{
+				input: [2]uintptr{1, 2},
+				want:  3,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AddSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestSubSat(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{1, 2},
+				want:  -1,
+			},
+			{
+				input: [2]myInt{math.MinInt, 1},
+				want:  math.MinInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{math.MaxInt, -1},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{math.MinInt, 1},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{0, math.MinInt},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{-1, math.MinInt},
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{-100, 100},
+				want:  math.MinInt8,
+			},
+			{
+				input: [2]int8{100, -100},
+				want:  math.MaxInt8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input [2]int16
+			want  int16
+		}{
+			{
+				input: [2]int16{math.MinInt16, 1},
+				want:  math.MinInt16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input [2]int32
+			want  int32
+		}{
+			{
+				input: [2]int32{math.MaxInt32, -1},
+				want:  math.MaxInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input [2]int64
+			want  int64
+		}{
+			{
+				input: [2]int64{math.MinInt64, math.MaxInt64},
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint
+			want  uint
+		}{
+			{
+				input: [2]uint{0, 1},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{1, 2},
+				want:  0,
+			},
+			{
+				input: [2]uint8{255, 1},
+				want:  254,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint16
+			want  uint16
+		}{
+			{
+				input: [2]uint16{0, math.MaxUint16},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint32
+			want  uint32
+		}{
+			{
+				input: [2]uint32{0, 1},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  uint64
+		}{
+			{
+				input: [2]uint64{1, math.MaxUint64},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input [2]uintptr
+			want  uintptr
+		}{
+			{
+				input: [2]uintptr{3, 2},
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SubSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestMulSat(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  myInt
+		}{
+			{
+				input: [2]myInt{-2, 3},
+				want:  -6,
+			},
+			{
+				input: [2]myInt{math.MinInt, -1},
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input [2]int
+			want  int
+		}{
+			{
+				input: [2]int{math.MinInt, -1},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{math.MaxInt, 2},
+				want:  math.MaxInt,
+			},
+			{
+				input: [2]int{math.MaxInt, -2},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MinInt, 2},
+				want:  math.MinInt,
+			},
+			{
+				input: [2]int{math.MinInt, 0},
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input [2]int8
+			want  int8
+		}{
+			{
+				input: [2]int8{64, 2},
+				want:  math.MaxInt8,
+			},
+			{
+				input: [2]int8{64, -4},
+				want:  math.MinInt8,
+			},
+			{
+				input: [2]int8{-64, 2},
+				want:  -128,
+			},
+			{
+				input: [2]int8{-128, -1},
+				want:  math.MaxInt8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input [2]int16
+			want  int16
+		}{
+			{
+				input: [2]int16{math.MinInt16, -1},
+				want:  math.MaxInt16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input [2]int32
+			want  int32
+		}{
+			{
+				input: [2]int32{math.MinInt32, math.MinInt32},
+				want:  math.MaxInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input [2]int64
+			want  int64
+		}{
+			{
+				input: [2]int64{math.MaxInt64, math.MinInt64},
+				want:  math.MinInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint
+			want  uint
+		}{
+			{
+				input: [2]uint{math.MaxUint, 2},
+				want:  math.MaxUint,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint8
+			want  uint8
+		}{
+			{
+				input: [2]uint8{15, 17},
+				want:  255,
+			},
+			{
+				input: [2]uint8{16, 16},
+				want:  math.MaxUint8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint16
+			want  uint16
+		}{
+			{
+				input: [2]uint16{256, 256},
+				want:  math.MaxUint16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint32
+			want  uint32
+		}{
+			{
+				input: [2]uint32{65536, 65536},
+				want:  math.MaxUint32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input [2]uint64
+			want  uint64
+		}{
+			{
+				input: [2]uint64{1 << 32, 1 << 32},
+				want:  math.MaxUint64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input [2]uintptr
+			want  uintptr
+		}{
+			{
+				input: [2]uintptr{2, 3},
+				want:  6,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := MulSat(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestNegSat(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: 1,
+				want:  -1,
+			},
+			{
+				input: math.MinInt,
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  int
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: math.MaxInt,
+				want:  -math.MaxInt,
+			},
+			{
+				input: math.MinInt,
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input int8
+			want  int8
+		}{
+			{
+				input: -127,
+				want:  127,
+			},
+			{
+				input: -128,
+				want:  math.MaxInt8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input int16
+			want  int16
+		}{
+			{
+				input: math.MinInt16,
+				want:  math.MaxInt16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input int32
+			want  int32
+		}{
+			{
+				input: math.MinInt32,
+				want:  math.MaxInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MinInt64,
+				want:  math.MaxInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input uint
+			want  uint
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: 255,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input uint16
+			want  uint16
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input uint32
+			want  uint32
+		}{
+			{
+				input: 1,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input uint64
+			want  uint64
+		}{
+			{
+				input: math.MaxUint64,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input uintptr
+			want  uintptr
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := NegSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestAbsSat(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  myInt
+		}{
+			{
+				input: -1,
+				want:  1,
+			},
+			{
+				input: math.MinInt,
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  int
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: math.MaxInt,
+				want:  math.MaxInt,
+			},
+			{
+				input: -math.MaxInt,
+				want:  math.MaxInt,
+			},
+			{
+				input: math.MinInt,
+				want:  math.MaxInt,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8", func(t *testing.T) {
+		tests := []struct {
+			input int8
+			want  int8
+		}{
+			{
+				input: -127,
+				want:  127,
+			},
+			{
+				input: -128,
+				want:  math.MaxInt8,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int16", func(t *testing.T) {
+		tests := []struct {
+			input int16
+			want  int16
+		}{
+			{
+				input: math.MinInt16,
+				want:  math.MaxInt16,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input int32
+			want  int32
+		}{
+			{
+				input: math.MinInt32,
+				want:  math.MaxInt32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int64", func(t *testing.T) {
+		tests := []struct {
+			input int64
+			want  int64
+		}{
+			{
+				input: math.MinInt64,
+				want:  math.MaxInt64,
+			},
+			{
+				input: math.MinInt64 + 1,
+				want:  math.MaxInt64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint", func(t *testing.T) {
+		tests := []struct {
+			input uint
+			want  uint
+		}{
+			{
+				input: math.MaxUint,
+				want:  math.MaxUint,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		tests := []struct {
+			input uint8
+			want  uint8
+		}{
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: 255,
+				want:  255,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint16", func(t *testing.T) {
+		tests := []struct {
+			input uint16
+			want  uint16
+		}{
+			{
+				input: 1,
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint32", func(t *testing.T) {
+		tests := []struct {
+			input uint32
+			want  uint32
+		}{
+			{
+				input: math.MaxUint32,
+				want:  math.MaxUint32,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uint64", func(t *testing.T) {
+		tests := []struct {
+			input uint64
+			want  uint64
+		}{
+			{
+				input: math.MaxUint64,
+				want:  math.MaxUint64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("uintptr", func(t *testing.T) {
+		tests := []struct {
+			input uintptr
+			want  uintptr
+		}{
+			{
+				input: 1,
+				want:  1,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := AbsSat(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+// TestSatExhaustive checks the saturating arithmetic functions against
+// arithmetic in a wider type for every int8 and uint8 combination.
+func TestSatExhaustive(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			a := int8(x)
+			assertCall(t, sat[int8](-x), NegSat(a), "NegSat", x)
+			assertCall(t, sat[int8](Abs(x)), AbsSat(a), "AbsSat", x)
+			for y := math.MinInt8; y <= math.MaxInt8; y++ {
+				b := int8(y)
+				assertCall(t, sat[int8](x+y), AddSat(a, b), "AddSat", x, y)
+				assertCall(t, sat[int8](x-y), SubSat(a, b), "SubSat", x, y)
+				assertCall(t, sat[int8](x*y), MulSat(a, b), "MulSat", x, y)
+			}
+		}
+	})
+	t.Run("uint8", func(t *testing.T) {
+		for x := 0; x <= math.MaxUint8; x++ {
+			a := uint8(x)
+			assertCall(t, sat[uint8](-x), NegSat(a), "NegSat", x)
+			assertCall(t, sat[uint8](x), AbsSat(a), "AbsSat", x)
+			for y := 0; y <= math.MaxUint8; y++ {
+				b := uint8(y)
+				assertCall(t, sat[uint8](x+y), AddSat(a, b), "AddSat", x, y)
+				assertCall(t, sat[uint8](x-y), SubSat(a, b), "SubSat", x, y)
+				assertCall(t, sat[uint8](x*y), MulSat(a, b), "MulSat", x, y)
+			}
+		}
+	})
+}
+
+// sat returns the exact result v of a saturating arithmetic function, computed
+// in a wider type, clamped to the range of T.
+func sat[T Integer](v int) T {
+	return T(Clamp(v, int(MinValue[T]()), int(MaxValue[T]())))
+}
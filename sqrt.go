@@ -0,0 +1,157 @@
+package gmath
+
+import "math"
+
+// Sqrt returns the square root of x.
+//
+// Special cases are:
+//
+//	Sqrt(+Inf) = +Inf
+//	Sqrt(±0) = ±0
+//	Sqrt(x < 0) = NaN
+//	Sqrt(NaN) = NaN
+func Sqrt[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	if is32[T]() {
+		return T(sqrt32(float32(x)))
+	}
+	return T(math.Sqrt(float64(x)))
+}
+
+// Cbrt returns the cube root of x.
+//
+// Special cases are:
+//
+//	Cbrt(±0) = ±0
+//	Cbrt(±Inf) = ±Inf
+//	Cbrt(NaN) = NaN
+func Cbrt[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Cbrt(float64(x)))
+}
+
+// Hypot returns Sqrt(p*p + q*q), taking care to avoid unnecessary overflow and
+// underflow.
+//
+// Special cases are:
+//
+//	Hypot(±Inf, q) = +Inf
+//	Hypot(p, ±Inf) = +Inf
+//	Hypot(NaN, q) = NaN
+//	Hypot(p, NaN) = NaN
+//
+// For float32 arguments, Hypot computes the result using float32 arithmetic
+// rather than converting the arguments to float64.
+func Hypot[T Float](p, q T) T {
+	if is32[T]() {
+		return T(hypot32(float32(p), float32(q)))
+	}
+	// special cases
+	switch {
+	case IsInf(p, 0) || IsInf(q, 0):
+		return T(math.Inf(1))
+	case IsNaN(p):
+		return p
+	case IsNaN(q):
+		return q
+	}
+	return T(math.Hypot(float64(p), float64(q)))
+}
+
+// RSqrt returns the reciprocal square root of x, 1/Sqrt(x).
+//
+// Special cases are:
+//
+//	RSqrt(+Inf) = +0
+//	RSqrt(±0) = ±Inf
+//	RSqrt(x < 0) = NaN
+//	RSqrt(NaN) = NaN
+//
+// Note that the result is computed as 1/Sqrt(x), so it may differ from the
+// correctly rounded reciprocal square root by one unit in the last place.
+func RSqrt[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return 1 / Sqrt(x)
+}
+
+// SqrtInt returns the square root of x.
+//
+// Special cases are:
+//
+//	SqrtInt(x < 0) = NaN
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func SqrtInt[T Integer](x T) float64 {
+	return math.Sqrt(float64(x))
+}
+
+// CbrtInt returns the cube root of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func CbrtInt[T Integer](x T) float64 {
+	return math.Cbrt(float64(x))
+}
+
+// HypotInt returns Sqrt(p*p + q*q), taking care to avoid unnecessary overflow.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the inputs are
+// converted to float64.
+func HypotInt[T Integer](p, q T) float64 {
+	return math.Hypot(float64(p), float64(q))
+}
+
+// RSqrtInt returns the reciprocal square root of x, 1/SqrtInt(x).
+//
+// Special cases are:
+//
+//	RSqrtInt(0) = +Inf
+//	RSqrtInt(x < 0) = NaN
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func RSqrtInt[T Integer](x T) float64 {
+	return 1 / math.Sqrt(float64(x))
+}
+
+// sqrt32 returns the square root of x. The float64 square root of a float32
+// value rounded to float32 is the correctly rounded float32 square root, and
+// the compiler implements this expression with a single float32 square root
+// instruction on architectures that have one.
+func sqrt32(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/hypot.go;l=19-40
+
+func hypot32(p, q float32) float32 {
+	// special cases
+	switch {
+	case IsInf(p, 0) || IsInf(q, 0):
+		return Inf32(1)
+	case IsNaN(p):
+		return p
+	case IsNaN(q):
+		return q
+	}
+	p, q = Abs(p), Abs(q)
+	if p < q {
+		p, q = q, p
+	}
+	if p == 0 {
+		return 0
+	}
+	q = q / p
+	return p * sqrt32(1+q*q)
+}
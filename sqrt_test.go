@@ -0,0 +1,431 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSqrt(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 2,
+				want:  1.4142135,
+			},
+			{
+				input: 0.5,
+				want:  0.70710677,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(1),
+				want:  Inf32(1),
+			},
+			{
+				input: -8,
+				want:  float32(math.Sqrt(-1)),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Sqrt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 2,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: -8,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Sqrt(test.input)
+				got := Sqrt(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestCbrt(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 27,
+				want:  3,
+			},
+			{
+				input: -8,
+				want:  -2,
+			},
+			{
+				input: 0.5,
+				want:  0.7937005,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(-1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Cbrt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 27,
+			},
+			{
+				input: -8,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Cbrt(test.input)
+				got := Cbrt(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestHypot(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{3, 4},
+				want:  5,
+			},
+			{
+				input: [2]float32{-3, 4},
+				want:  5,
+			},
+			{
+				input: [2]float32{3e30, 4e30},
+				want:  5e30,
+			},
+			{
+				input: [2]float32{3e-30, 4e-30},
+				want:  5e-30,
+			},
+			{
+				input: [2]float32{math.MaxFloat32, math.MaxFloat32},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{1, 1},
+				want:  1.4142135,
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  0,
+			},
+			{
+				input: [2]float32{Inf32(-1), NaN32()},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{NaN32(), Inf32(1)},
+				want:  Inf32(1),
+			},
+			{
+				input: [2]float32{NaN32(), 1},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{1, NaN32()},
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Hypot(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+		}{
+			{
+				input: [2]float64{3, 4},
+			},
+			{
+				input: [2]float64{3e300, 4e300},
+			},
+			{
+				input: [2]float64{3e-300, 4e-300},
+			},
+			{
+				input: [2]float64{math.Inf(-1), math.NaN()},
+			},
+			{
+				input: [2]float64{math.NaN(), 1},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Hypot(test.input[0], test.input[1])
+				got := Hypot(test.input[0], test.input[1])
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestRSqrt(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 4,
+				want:  0.5,
+			},
+			{
+				input: 0.5,
+				want:  1.4142135,
+			},
+			{
+				input: Inf32(1),
+				want:  0,
+			},
+			{
+				input: 0,
+				want:  Inf32(1),
+			},
+			{
+				input: negzero32(),
+				want:  Inf32(-1),
+			},
+			{
+				input: -8,
+				want:  float32(math.Sqrt(-1)),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := RSqrt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 4,
+			},
+			{
+				input: 0.5,
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: 0,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: -8,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := rsqrt64(test.input)
+				got := RSqrt(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+// rsqrt64 is the reference implementation of RSqrt for float64.
+func rsqrt64(x float64) float64 {
+	return 1 / math.Sqrt(x)
+}
+
+func TestSqrtInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 2,
+				want:  1.4142135623730951,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SqrtInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			input int
+			want  float64
+		}{
+			{
+				input: 16,
+				want:  4,
+			},
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: -1,
+				want:  math.Sqrt(-1),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SqrtInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestCbrtInt(t *testing.T) {
+	tests := []struct {
+		input int64
+		want  float64
+	}{
+		{
+			input: -27,
+			want:  -3,
+		},
+		{
+			input: 8,
+			want:  2,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := CbrtInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestHypotInt(t *testing.T) {
+	tests := []struct {
+		input [2]uint8
+		want  float64
+	}{
+		{
+			input: [2]uint8{3, 4},
+			want:  5,
+		},
+		{
+			input: [2]uint8{255, 255},
+			want:  360.62445840513925,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := HypotInt(test.input[0], test.input[1])
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestRSqrtInt(t *testing.T) {
+	tests := []struct {
+		input int
+		want  float64
+	}{
+		{
+			input: 4,
+			want:  0.5,
+		},
+		{
+			input: 0,
+			want:  math.Inf(1),
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := RSqrtInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
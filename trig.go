@@ -0,0 +1,560 @@
+package gmath
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Sin returns the sine of the radian argument x.
+//
+// Special cases are:
+//
+//	Sin(±0) = ±0
+//	Sin(±Inf) = NaN
+//	Sin(NaN) = NaN
+//
+// For float32 values, Sin reduces and evaluates the argument using float32
+// arithmetic rather than converting it to a float64. Use SinInt for integer
+// values.
+func Sin[T Float](x T) T {
+	if is32[T]() {
+		return T(sin32(float32(x)))
+	}
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Sin(float64(x)))
+}
+
+// Cos returns the cosine of the radian argument x.
+//
+// Special cases are:
+//
+//	Cos(±Inf) = NaN
+//	Cos(NaN) = NaN
+//
+// For float32 values, Cos reduces and evaluates the argument using float32
+// arithmetic rather than converting it to a float64. Use CosInt for integer
+// values.
+func Cos[T Float](x T) T {
+	if is32[T]() {
+		return T(cos32(float32(x)))
+	}
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Cos(float64(x)))
+}
+
+// Tan returns the tangent of the radian argument x.
+//
+// Special cases are:
+//
+//	Tan(±0) = ±0
+//	Tan(±Inf) = NaN
+//	Tan(NaN) = NaN
+//
+// For float32 values, Tan reduces and evaluates the argument using float32
+// arithmetic rather than converting it to a float64. Use TanInt for integer
+// values.
+func Tan[T Float](x T) T {
+	if is32[T]() {
+		return T(tan32(float32(x)))
+	}
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Tan(float64(x)))
+}
+
+// Sincos returns Sin(x), Cos(x).
+//
+// Special cases are:
+//
+//	Sincos(±0) = ±0, 1
+//	Sincos(±Inf) = NaN, NaN
+//	Sincos(NaN) = NaN, NaN
+//
+// Use SincosInt for integer values.
+func Sincos[T Float](x T) (sin, cos T) {
+	if is32[T]() {
+		s, c := sincos32(float32(x))
+		return T(s), T(c)
+	}
+	if IsNaN(x) {
+		return x, x
+	}
+	s, c := math.Sincos(float64(x))
+	return T(s), T(c)
+}
+
+// Asin returns the arcsine, in radians, of x.
+//
+// Special cases are:
+//
+//	Asin(±0) = ±0
+//	Asin(x) = NaN if x < -1 or x > 1
+//	Asin(NaN) = NaN
+//
+// Use AsinInt for integer values.
+func Asin[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Asin(float64(x)))
+}
+
+// Acos returns the arccosine, in radians, of x.
+//
+// Special cases are:
+//
+//	Acos(x) = NaN if x < -1 or x > 1
+//	Acos(NaN) = NaN
+//
+// Use AcosInt for integer values.
+func Acos[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Acos(float64(x)))
+}
+
+// Atan returns the arctangent, in radians, of x.
+//
+// Special cases are:
+//
+//	Atan(±0) = ±0
+//	Atan(±Inf) = ±Pi/2
+//	Atan(NaN) = NaN
+//
+// Use AtanInt for integer values.
+func Atan[T Float](x T) T {
+	if IsNaN(x) {
+		return x
+	}
+	return T(math.Atan(float64(x)))
+}
+
+// Atan2 returns the arc tangent of y/x, using the signs of the two to
+// determine the quadrant of the return value. The result has the type of y.
+//
+// Special cases are (in order):
+//
+//	Atan2(y, NaN) = NaN
+//	Atan2(NaN, x) = NaN
+//	Atan2(+0, x>=0) = +0
+//	Atan2(-0, x>=0) = -0
+//	Atan2(+0, x<=-0) = +Pi
+//	Atan2(-0, x<=-0) = -Pi
+//	Atan2(y>0, 0) = +Pi/2
+//	Atan2(y<0, 0) = -Pi/2
+//	Atan2(+Inf, +Inf) = +Pi/4
+//	Atan2(-Inf, +Inf) = -Pi/4
+//	Atan2(+Inf, -Inf) = 3Pi/4
+//	Atan2(-Inf, -Inf) = -3Pi/4
+//	Atan2(y, +Inf) = 0
+//	Atan2(y>0, -Inf) = +Pi
+//	Atan2(y<0, -Inf) = -Pi
+//	Atan2(+Inf, x) = +Pi/2
+//	Atan2(-Inf, x) = -Pi/2
+//
+// If x is a NaN, it is converted to the type of y. Converting a NaN between
+// float32 and float64 may change its bits.
+//
+// Use Atan2Int if y is an integer, for example Atan2Int(int32(1), int32(1)).
+func Atan2[T0 Float, T1 Integer | Float](y T0, x T1) T0 {
+	// special cases
+	switch {
+	case IsNaN(x):
+		return T0(x)
+	case IsNaN(y):
+		return y
+	}
+	return T0(math.Atan2(float64(y), float64(x)))
+}
+
+// SinInt returns the sine of the radian argument x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func SinInt[T Integer](x T) float64 {
+	return math.Sin(float64(x))
+}
+
+// CosInt returns the cosine of the radian argument x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func CosInt[T Integer](x T) float64 {
+	return math.Cos(float64(x))
+}
+
+// TanInt returns the tangent of the radian argument x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func TanInt[T Integer](x T) float64 {
+	return math.Tan(float64(x))
+}
+
+// SincosInt returns SinInt(x), CosInt(x).
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func SincosInt[T Integer](x T) (sin, cos float64) {
+	return math.Sincos(float64(x))
+}
+
+// AsinInt returns the arcsine, in radians, of x.
+//
+// Special cases are:
+//
+//	AsinInt(0) = 0
+//	AsinInt(x) = NaN if x < -1 or x > 1
+func AsinInt[T Integer](x T) float64 {
+	return math.Asin(float64(x))
+}
+
+// AcosInt returns the arccosine, in radians, of x.
+//
+// Special cases are:
+//
+//	AcosInt(x) = NaN if x < -1 or x > 1
+func AcosInt[T Integer](x T) float64 {
+	return math.Acos(float64(x))
+}
+
+// AtanInt returns the arctangent, in radians, of x.
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the input is converted
+// to a float64.
+func AtanInt[T Integer](x T) float64 {
+	return math.Atan(float64(x))
+}
+
+// Atan2Int returns the arc tangent of y/x, using the signs of the two to
+// determine the quadrant of the return value.
+//
+// Special cases are:
+//
+//	Atan2Int(0, x>=0) = 0
+//	Atan2Int(0, x<0) = +Pi
+//	Atan2Int(y>0, 0) = +Pi/2
+//	Atan2Int(y<0, 0) = -Pi/2
+//
+// Note that for integer values greater than 9007199254740993 or less than
+// -9007199254740993, some precision may be lost because the inputs are
+// converted to float64.
+func Atan2Int[T0, T1 Integer](y T0, x T1) float64 {
+	return math.Atan2(float64(y), float64(x))
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/sin.go
+// and https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/tan.go,
+// using the float32 coefficients from the Cephes Math Library files sinf.c,
+// cosf.c and tanf.c.
+
+var _sin32 = [...]float32{
+	-1.9515295891e-4,
+	8.3321608736e-3,
+	-1.6666654611e-1,
+}
+
+var _cos32 = [...]float32{
+	2.443315711809948e-5,
+	-1.388731625493765e-3,
+	4.166664568298827e-2,
+}
+
+var _tan32 = [...]float32{
+	9.38540185543e-3,
+	3.11992232697e-3,
+	2.44301354525e-2,
+	5.34112807005e-2,
+	1.33387994085e-1,
+	3.33331568548e-1,
+}
+
+// reduceThreshold32 is the maximum value of x where the reduction using Pi/4
+// in 4 float32 parts still gives accurate results. This threshold is set by
+// y*C being representable as a float32 without error where y is given by
+// y = floor(x * (4 / Pi)) and C is the leading partial terms of Pi/4. Since
+// the leading terms (PI4A32, PI4B32 and PI4C32) have at most 11 significant
+// bits, y should have less than 13 significant bits.
+//
+//	y < 1<<13 -> floor(x*4/Pi) < 1<<13 -> x < (1<<13 - 1) * Pi/4
+//
+// So, conservatively we can take x < 1<<12.
+// Above this threshold Payne-Hanek range reduction must be used.
+const reduceThreshold32 = 1 << 12
+
+// trigReduce32 reduces x >= 0 modulo Pi/4 using float32 arithmetic. It
+// returns the octant modulo 2Pi radians (j) and the reduced argument (z), with
+// the zeros of odd octants mapped to the origin.
+func trigReduce32(x float32) (j uint32, z float32) {
+	const (
+		PI4A32 = 0.78515625                          // 0x3f490000, Pi/4 split into four parts
+		PI4B32 = 2.4187564849853515625e-4            // 0x397da000,
+		PI4C32 = 3.774766810238361358642578e-8       // 0x33222000,
+		PI4D32 = 1.281672034128544801490079e-12      // 0x2bb4611a,
+		M4PI32 = 1.273239544735162542821171882678754 // 4/Pi
+	)
+	if x >= reduceThreshold32 {
+		return trigReduceHuge32(x)
+	}
+	j = uint32(x * M4PI32) // integer part of x/(Pi/4), as integer for tests on the phase angle
+	y := float32(j)        // integer part of x/(Pi/4), as float
+
+	if j&1 == 1 {
+		j++
+		y++
+	}
+	j &= 7 // octant modulo 2Pi radians (360 degrees)
+	// Extended precision modular arithmetic. The explicit conversions prevent
+	// the products from being fused with the subtractions.
+	z = x - float32(y*PI4A32)
+	z -= float32(y * PI4B32)
+	z -= float32(y * PI4C32)
+	z -= float32(y * PI4D32)
+	return j, z
+}
+
+// Based on https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/trig_reduce.go;l=27-70
+
+// trigReduceHuge32 implements Payne-Hanek range reduction by Pi/4 for
+// float32 x >= reduceThreshold32. It returns the integer part mod 8 (j) and
+// the fractional part (z) of x / (Pi/4), with the zeros of odd octants mapped
+// to the origin. The simulated multi-precision calculation of x*B uses 64-bit
+// integer arithmetic.
+func trigReduceHuge32(x float32) (j uint32, z float32) {
+	// Extract out the integer and exponent such that,
+	// x = ix * 2 ** exp.
+	ix := math.Float32bits(x)
+	exp := int(ix>>shift32&mask32) - bias32 - shift32
+	ix &^= mask32 << shift32
+	ix |= 1 << shift32
+	// Use the exponent to extract the 3 appropriate uint64 digits from mPi4,
+	// B ~ (z0, z1, z2), such that the product leading digit has the exponent -61.
+	// Note, exp >= -11 since x >= reduceThreshold32 and exp <= 104 for maximum
+	// float32.
+	digit, bitshift := uint(exp+61)/64, uint(exp+61)%64
+	z0 := (mPi4[digit] << bitshift) | (mPi4[digit+1] >> (64 - bitshift))
+	z1 := (mPi4[digit+1] << bitshift) | (mPi4[digit+2] >> (64 - bitshift))
+	z2 := (mPi4[digit+2] << bitshift) | (mPi4[digit+3] >> (64 - bitshift))
+	// Multiply mantissa by the digits and extract the upper two digits (hi, lo).
+	z2hi, _ := bits.Mul64(z2, uint64(ix))
+	z1hi, z1lo := bits.Mul64(z1, uint64(ix))
+	z0lo := z0 * uint64(ix)
+	lo, c := bits.Add64(z1lo, z2hi, 0)
+	hi, _ := bits.Add64(z0lo, z1hi, c)
+	// The top 3 bits are j.
+	j = uint32(hi >> 61)
+	// Extract the fraction as a 128-bit fixed point number (fhi, flo).
+	fhi, flo := hi<<3|lo>>61, lo<<3
+	// Map zeros to origin. Negating the fixed point fraction computes 1-z
+	// exactly, so no precision is lost when x is close to a multiple of Pi/4.
+	sign := false
+	if j&1 == 1 {
+		j++
+		j &= 7
+		var b uint64
+		flo, b = bits.Sub64(0, flo, 0)
+		fhi, _ = bits.Sub64(0, fhi, b)
+		sign = true
+	}
+	// Normalize the fraction so that its leading one bit is the top bit of fhi.
+	lz := uint(bits.LeadingZeros64(fhi))
+	fhi = fhi<<lz | flo>>(64-lz)
+	// Multiply the fractional part by Pi/4 and find the magnitude of the
+	// product.
+	m, _ := bits.Mul64(fhi, mPi4Fixed)
+	mlz := uint(bits.LeadingZeros64(m))
+	m <<= mlz
+	e := uint32(bias32 - 1 - lz - mlz)
+	// Round the mantissa to nearest even and convert to a float.
+	const (
+		dropped = 64 - shift32 - 1
+		half    = 1 << (dropped - 1)
+	)
+	f := uint32(m >> dropped)
+	if rem := m & (1<<dropped - 1); rem > half || rem == half && f&1 == 1 {
+		f++
+		if f == 1<<(shift32+1) {
+			f >>= 1
+			e++
+		}
+	}
+	z = math.Float32frombits(e<<shift32 | f&^(1<<shift32))
+	if sign {
+		z = -z
+	}
+	return j, z
+}
+
+// mPi4Fixed is Pi/4 as a 64-bit fixed point number, that is,
+// Pi/4 ~ mPi4Fixed*2^(-64).
+const mPi4Fixed = 0xc90fdaa22168c235
+
+// mPi4 is the leading binary digits of 4/pi as a uint64 array, that is,
+// 4/pi = Sum mPi4[i]*2^(-64*i). These 5 64-bit digits and the leading one bit
+// handle the largest possible float32 exponent. Copied from
+// https://cs.opensource.google/go/go/+/refs/tags/go1.19.3:src/math/trig_reduce.go;l=72-96
+var mPi4 = [...]uint64{
+	0x0000000000000001,
+	0x45f306dc9c882a53,
+	0xf84eafa3ea69bb81,
+	0xb6c52b3278872083,
+	0xfca2c757bd778ac3,
+	0x6e48dc74849ba5c0,
+}
+
+// sinPoly32 evaluates the sine polynomial for the reduced argument z.
+func sinPoly32(z float32) float32 {
+	zz := z * z
+	return z + z*zz*((_sin32[0]*zz+_sin32[1])*zz+_sin32[2])
+}
+
+// cosPoly32 evaluates the cosine polynomial for the reduced argument z.
+func cosPoly32(z float32) float32 {
+	zz := z * z
+	return 1.0 - 0.5*zz + zz*zz*((_cos32[0]*zz+_cos32[1])*zz+_cos32[2])
+}
+
+func sin32(x float32) float32 {
+	// special cases
+	switch {
+	case x == 0 || IsNaN(x):
+		return x // return ±0 || NaN()
+	case IsInf(x, 0):
+		return float32(math.NaN())
+	}
+
+	sign := false
+	if x < 0 {
+		x = -x
+		sign = true
+	}
+
+	j, z := trigReduce32(x)
+	if j > 3 {
+		sign = !sign
+		j -= 4
+	}
+	var y float32
+	if j == 1 || j == 2 {
+		y = cosPoly32(z)
+	} else {
+		y = sinPoly32(z)
+	}
+	if sign {
+		y = -y
+	}
+	return y
+}
+
+func cos32(x float32) float32 {
+	// special cases
+	switch {
+	case IsNaN(x):
+		return x
+	case IsInf(x, 0):
+		return float32(math.NaN())
+	}
+
+	sign := false
+	x = Abs(x)
+
+	j, z := trigReduce32(x)
+	if j > 3 {
+		j -= 4
+		sign = !sign
+	}
+	if j > 1 {
+		sign = !sign
+	}
+	var y float32
+	if j == 1 || j == 2 {
+		y = sinPoly32(z)
+	} else {
+		y = cosPoly32(z)
+	}
+	if sign {
+		y = -y
+	}
+	return y
+}
+
+func tan32(x float32) float32 {
+	// special cases
+	switch {
+	case x == 0 || IsNaN(x):
+		return x // return ±0 || NaN()
+	case IsInf(x, 0):
+		return float32(math.NaN())
+	}
+
+	sign := false
+	if x < 0 {
+		x = -x
+		sign = true
+	}
+
+	j, z := trigReduce32(x)
+	zz := z * z
+	y := z + z*zz*(((((_tan32[0]*zz+_tan32[1])*zz+_tan32[2])*zz+_tan32[3])*zz+_tan32[4])*zz+_tan32[5])
+	if j&2 == 2 {
+		y = -1 / y
+	}
+	if sign {
+		y = -y
+	}
+	return y
+}
+
+func sincos32(x float32) (sin, cos float32) {
+	// special cases
+	switch {
+	case x == 0:
+		return x, 1 // return ±0.0, 1.0
+	case IsNaN(x):
+		return x, x
+	case IsInf(x, 0):
+		nan := float32(math.NaN())
+		return nan, nan
+	}
+
+	sinSign, cosSign := false, false
+	if x < 0 {
+		x = -x
+		sinSign = true
+	}
+
+	j, z := trigReduce32(x)
+	if j > 3 { // reflect in x axis
+		j -= 4
+		sinSign, cosSign = !sinSign, !cosSign
+	}
+	if j > 1 {
+		cosSign = !cosSign
+	}
+
+	if j == 1 || j == 2 {
+		cos = sinPoly32(z)
+		sin = cosPoly32(z)
+	} else {
+		sin = sinPoly32(z)
+		cos = cosPoly32(z)
+	}
+	if cosSign {
+		cos = -cos
+	}
+	if sinSign {
+		sin = -sin
+	}
+	return
+}
@@ -0,0 +1,947 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestSin(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.47942555,
+			},
+			{
+				input: -0.5,
+				want:  -0.47942555,
+			},
+			{
+				input: math.Pi,
+				want:  -8.742278e-08,
+			},
+			{
+				input: 100000,
+				want:  0.0357488,
+			},
+			{
+				input: 1e-20,
+				want:  1e-20,
+			},
+			{
+				input: 0,
+				want:  0,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(1),
+				want:  float32(math.NaN()),
+			},
+			{
+				input: Inf32(-1),
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Sin(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: math.Pi,
+			},
+			{
+				input: 1e300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Sin(test.input)
+				got := Sin(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestCos(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.87758255,
+			},
+			{
+				input: -0.5,
+				want:  0.87758255,
+			},
+			{
+				input: math.Pi,
+				want:  -1,
+			},
+			{
+				input: 100000,
+				want:  -0.9993608,
+			},
+			{
+				input: 0,
+				want:  1,
+			},
+			{
+				input: negzero32(),
+				want:  1,
+			},
+			{
+				input: Inf32(1),
+				want:  float32(math.NaN()),
+			},
+			{
+				input: Inf32(-1),
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Cos(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: math.Pi,
+			},
+			{
+				input: 1e300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Cos(test.input)
+				got := Cos(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestTan(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.5463025,
+			},
+			{
+				input: -0.5,
+				want:  -0.5463025,
+			},
+			{
+				input: math.Pi,
+				want:  8.742278e-08,
+			},
+			{
+				input: 100000,
+				want:  -0.035771664,
+			},
+			{
+				input: 1e-20,
+				want:  1e-20,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(1),
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Tan(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -0.5,
+			},
+			{
+				input: math.Pi,
+			},
+			{
+				input: 1e300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Tan(test.input)
+				got := Tan(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestSincos(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  [2]float32
+		}{
+			{
+				input: 0.5,
+				want:  [2]float32{0.47942555, 0.87758255},
+			},
+			{
+				input: 100000,
+				want:  [2]float32{0.0357488, -0.9993608},
+			},
+			{
+				input: negzero32(),
+				want:  [2]float32{negzero32(), 1},
+			},
+			{
+				input: Inf32(-1),
+				want:  [2]float32{float32(math.NaN()), float32(math.NaN())},
+			},
+			{
+				input: NaN32(),
+				want:  [2]float32{NaN32(), NaN32()},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				sin, cos := Sincos(test.input)
+				assertEqual(t, test.want[0], sin)
+				assertEqual(t, test.want[1], cos)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: 1e300,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(-1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				wantSin, wantCos := math.Sincos(test.input)
+				sin, cos := Sincos(test.input)
+				assertEqual(t, wantSin, sin)
+				assertEqual(t, wantCos, cos)
+			})
+		}
+	})
+}
+
+func TestAsin(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.5235988,
+			},
+			{
+				input: -0.5,
+				want:  -0.5235988,
+			},
+			{
+				input: 1,
+				want:  math.Pi / 2,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: 2,
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Asin(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -1,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: 2,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Asin(test.input)
+				got := Asin(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestAcos(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  1.0471976,
+			},
+			{
+				input: -0.5,
+				want:  2.0943952,
+			},
+			{
+				input: 1,
+				want:  0,
+			},
+			{
+				input: -2,
+				want:  float32(math.NaN()),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Acos(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -1,
+			},
+			{
+				input: 2,
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Acos(test.input)
+				got := Acos(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestAtan(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 0.5,
+				want:  0.4636476,
+			},
+			{
+				input: -2,
+				want:  -1.1071488,
+			},
+			{
+				input: negzero32(),
+				want:  negzero32(),
+			},
+			{
+				input: Inf32(1),
+				want:  math.Pi / 2,
+			},
+			{
+				input: Inf32(-1),
+				want:  -math.Pi / 2,
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Atan(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+		}{
+			{
+				input: 0.5,
+			},
+			{
+				input: -2,
+			},
+			{
+				input: negzero64(),
+			},
+			{
+				input: math.Inf(1),
+			},
+			{
+				input: math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Atan(test.input)
+				got := Atan(test.input)
+				assertEqual(t, want, got)
+			})
+		}
+	})
+}
+
+func TestAtan2(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  float32
+		}{
+			{
+				input: [2]float32{1, 1},
+				want:  math.Pi / 4,
+			},
+			{
+				input: [2]float32{-3, 4},
+				want:  -0.6435011,
+			},
+			{
+				input: [2]float32{1, NaN32()},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{NaN32(), 1},
+				want:  NaN32(),
+			},
+			{
+				input: [2]float32{0, 1},
+				want:  0,
+			},
+			{
+				input: [2]float32{negzero32(), 1},
+				want:  negzero32(),
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  math.Pi,
+			},
+			{
+				input: [2]float32{negzero32(), -1},
+				want:  -math.Pi,
+			},
+			{
+				input: [2]float32{1, 0},
+				want:  math.Pi / 2,
+			},
+			{
+				input: [2]float32{-1, 0},
+				want:  -math.Pi / 2,
+			},
+			{
+				input: [2]float32{Inf32(1), Inf32(1)},
+				want:  math.Pi / 4,
+			},
+			{
+				input: [2]float32{Inf32(-1), Inf32(1)},
+				want:  -math.Pi / 4,
+			},
+			{
+				input: [2]float32{Inf32(1), Inf32(-1)},
+				want:  3 * math.Pi / 4,
+			},
+			{
+				input: [2]float32{Inf32(-1), Inf32(-1)},
+				want:  -3 * math.Pi / 4,
+			},
+			{
+				input: [2]float32{1, Inf32(1)},
+				want:  0,
+			},
+			{
+				input: [2]float32{1, Inf32(-1)},
+				want:  math.Pi,
+			},
+			{
+				input: [2]float32{-1, Inf32(-1)},
+				want:  -math.Pi,
+			},
+			{
+				input: [2]float32{Inf32(1), 1},
+				want:  math.Pi / 2,
+			},
+			{
+				input: [2]float32{Inf32(-1), 1},
+				want:  -math.Pi / 2,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Atan2(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+		}{
+			{
+				input: [2]float64{1, 1},
+			},
+			{
+				input: [2]float64{-3, 4},
+			},
+			{
+				input: [2]float64{negzero64(), -1},
+			},
+			{
+				input: [2]float64{math.Inf(1), math.Inf(-1)},
+			},
+			{
+				input: [2]float64{-1, math.Inf(-1)},
+			},
+			{
+				input: [2]float64{math.NaN(), 1},
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				want := math.Atan2(test.input[0], test.input[1])
+				got := Atan2(test.input[0], test.input[1])
+				assertEqual(t, want, got)
+			})
+		}
+	})
+	t.Run("float32, int32", func(t *testing.T) {
+		tests := []struct {
+			y    float32
+			x    int32
+			want float32
+		}{
+			{
+				y:    1,
+				x:    -1,
+				want: 3 * math.Pi / 4,
+			},
+			{
+				y:    negzero32(),
+				x:    0,
+				want: negzero32(),
+			},
+			{
+				y:    NaN32(),
+				x:    1,
+				want: NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.y, test.x), func(t *testing.T) {
+				got := Atan2(test.y, test.x)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestSinInt(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input myInt
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  -0.5440211108893699,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SinInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input int32
+			want  float64
+		}{
+			{
+				input: 10,
+				want:  -0.5440211108893699,
+			},
+			{
+				input: -1,
+				want:  -0.8414709848078965,
+			},
+			{
+				input: 0,
+				want:  0,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := SinInt(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestCosInt(t *testing.T) {
+	tests := []struct {
+		input uint64
+		want  float64
+	}{
+		{
+			input: 10,
+			want:  -0.8390715290764524,
+		},
+		{
+			input: 0,
+			want:  1,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := CosInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestTanInt(t *testing.T) {
+	tests := []struct {
+		input int
+		want  float64
+	}{
+		{
+			input: 10,
+			want:  0.6483608274590867,
+		},
+		{
+			input: -1,
+			want:  -1.557407724654902,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := TanInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestSincosInt(t *testing.T) {
+	tests := []struct {
+		input int16
+		want  [2]float64
+	}{
+		{
+			input: 10,
+			want:  [2]float64{-0.5440211108893699, -0.8390715290764524},
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			sin, cos := SincosInt(test.input)
+			assertEqual(t, test.want[0], sin)
+			assertEqual(t, test.want[1], cos)
+		})
+	}
+}
+
+func TestAsinInt(t *testing.T) {
+	tests := []struct {
+		input int8
+		want  float64
+	}{
+		{
+			input: 1,
+			want:  math.Pi / 2,
+		},
+		{
+			input: 0,
+			want:  0,
+		},
+		{
+			input: 2,
+			want:  math.NaN(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := AsinInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestAcosInt(t *testing.T) {
+	tests := []struct {
+		input int
+		want  float64
+	}{
+		{
+			input: -1,
+			want:  math.Pi,
+		},
+		{
+			input: 1,
+			want:  0,
+		},
+		{
+			input: 2,
+			want:  math.NaN(),
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := AcosInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestAtanInt(t *testing.T) {
+	tests := []struct {
+		input uint
+		want  float64
+	}{
+		{
+			input: 10,
+			want:  1.4711276743037345,
+		},
+		{
+			input: 0,
+			want:  0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+			got := AtanInt(test.input)
+			assertEqual(t, test.want, got)
+		})
+	}
+}
+
+func TestAtan2Int(t *testing.T) {
+	t.Run("myInt", func(t *testing.T) {
+		tests := []struct {
+			input [2]myInt
+			want  float64
+		}{
+			{
+				input: [2]myInt{0, -3},
+				want:  math.Pi,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Atan2Int(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int32", func(t *testing.T) {
+		tests := []struct {
+			input [2]int32
+			want  float64
+		}{
+			{
+				input: [2]int32{1, 1},
+				want:  math.Pi / 4,
+			},
+			{
+				input: [2]int32{-1, -1},
+				want:  -3 * math.Pi / 4,
+			},
+			{
+				input: [2]int32{0, 1},
+				want:  0,
+			},
+			{
+				input: [2]int32{0, -1},
+				want:  math.Pi,
+			},
+			{
+				input: [2]int32{1, 0},
+				want:  math.Pi / 2,
+			},
+			{
+				input: [2]int32{-1, 0},
+				want:  -math.Pi / 2,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Atan2Int(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("int8, uint64", func(t *testing.T) {
+		got := Atan2Int(int8(-1), uint64(1))
+		assertEqual(t, -math.Pi/4, got)
+	})
+}
+
+// TestTrig32Accuracy checks the float32 implementations against the float64
+// implementations rounded to float32.
+func TestTrig32Accuracy(t *testing.T) {
+	const maxULPs = 3
+	ulps := func(a, b float32) int64 {
+		d := int64(int32(math.Float32bits(a))) - int64(int32(math.Float32bits(b)))
+		if d < 0 {
+			return -d
+		}
+		return d
+	}
+	for x := float32(1e-6); !IsInf(x, 0); x *= 1.0001 {
+		if d := ulps(Sin(x), float32(math.Sin(float64(x)))); d > maxULPs {
+			t.Fatalf("Sin(%v): got %v, want %v (%d ULPs)", x, Sin(x), math.Sin(float64(x)), d)
+		}
+		if d := ulps(Cos(x), float32(math.Cos(float64(x)))); d > maxULPs {
+			t.Fatalf("Cos(%v): got %v, want %v (%d ULPs)", x, Cos(x), math.Cos(float64(x)), d)
+		}
+		if d := ulps(Tan(x), float32(math.Tan(float64(x)))); d > maxULPs {
+			t.Fatalf("Tan(%v): got %v, want %v (%d ULPs)", x, Tan(x), math.Tan(float64(x)), d)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package gmath
+
+import "math"
+
+// Ulp returns the unit in the last place of x, the distance between |x| and
+// the next representable value of larger magnitude.
+//
+// Special cases are:
+//
+//	Ulp(±0) = SmallestNonzero[T]()
+//	Ulp(±Inf) = +Inf
+//	Ulp(NaN) = NaN
+//	Ulp(±MaxValue[T]()) = MaxValue[T]() - Nextafter(MaxValue[T](), 0)
+func Ulp[T Float](x T) T {
+	// special cases
+	switch {
+	case IsNaN(x):
+		return x
+	case IsInf(x, 0):
+		return Inf[T](1)
+	}
+	a := Abs(x)
+	if a == MaxValue[T]() {
+		// There's no finite value of larger magnitude, so use the distance
+		// to the next value of smaller magnitude instead.
+		return a - Nextafter(a, 0)
+	}
+	return Nextafter(a, Inf[T](1)) - a
+}
+
+// UlpDistance returns the number of representable values of type T between a
+// and b, so UlpDistance(a, Nextafter(a, b)) = 1 for any a != b.
+//
+// Special cases are:
+//
+//	UlpDistance(+0, -0) = 0
+//	UlpDistance(±MaxValue[T](), ±Inf) = 1
+//	UlpDistance(a, NaN) = UlpDistance(NaN, b) = math.MaxUint64
+func UlpDistance[T Float](a, b T) uint64 {
+	if IsNaN(a) || IsNaN(b) {
+		return math.MaxUint64
+	}
+	ia, ib := orderedBits(a), orderedBits(b)
+	if ia < ib {
+		return uint64(ib) - uint64(ia)
+	}
+	return uint64(ia) - uint64(ib)
+}
+
+// orderedBits returns the bits of x as a signed integer that orders the same
+// way as x, with both +0 and -0 mapped to 0. x must not be a NaN.
+func orderedBits[T Float](x T) int64 {
+	var v int64
+	if is32[T]() {
+		v = int64(math.Float32bits(float32(x)) &^ signMask32)
+	} else {
+		v = int64(math.Float64bits(float64(x)) &^ signMask64)
+	}
+	if Signbit(x) {
+		return -v
+	}
+	return v
+}
+
+// A Tolerance specifies how far apart two values may be for AlmostEqual to
+// consider them equal. The zero value only allows values that are exactly
+// equal.
+type Tolerance struct {
+	// Rel is the largest allowed difference relative to the larger
+	// magnitude of the two values. For example, Rel = 1e-9 allows the values
+	// to differ in roughly their ninth significant digit.
+	Rel float64
+	// Abs is the largest allowed absolute difference. It is useful when
+	// comparing values near zero, where a relative tolerance is too strict.
+	Abs float64
+	// ULPs is the largest allowed distance in units in the last place, as
+	// reported by UlpDistance.
+	ULPs uint64
+}
+
+// AlmostEqual reports whether a and b are equal within any one of the
+// tolerances in tol.
+//
+// Special cases are:
+//
+//	AlmostEqual(±0, ±0, tol) = true
+//	AlmostEqual(±Inf, ±Inf, tol) = true if a and b have the same sign
+//	AlmostEqual(±Inf, b, tol) = false if b is finite
+//	AlmostEqual(a, NaN, tol) = AlmostEqual(NaN, b, tol) = false
+//
+// ±Inf is only equal to an infinity of the same sign, regardless of tol, even
+// though UlpDistance(MaxValue[T](), Inf[T](1)) = 1.
+func AlmostEqual[T Float](a, b T, tol Tolerance) bool {
+	// special cases
+	switch {
+	case IsNaN(a) || IsNaN(b):
+		return false
+	case a == b:
+		return true
+	case IsInf(a, 0) || IsInf(b, 0):
+		return false
+	}
+
+	// Compute the difference as a float64 so it can't overflow for float32
+	// values.
+	diff := math.Abs(float64(a) - float64(b))
+	if diff <= tol.Abs {
+		return true
+	}
+	if diff <= tol.Rel*math.Max(math.Abs(float64(a)), math.Abs(float64(b))) {
+		return true
+	}
+	return UlpDistance(a, b) <= tol.ULPs
+}
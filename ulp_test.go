@@ -0,0 +1,389 @@
+package gmath
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestUlp(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input float32
+			want  float32
+		}{
+			{
+				input: 1,
+				want:  0x1p-23,
+			},
+			{
+				input: -1,
+				want:  0x1p-23,
+			},
+			{
+				input: 1.5,
+				want:  0x1p-23,
+			},
+			{
+				input: 0x1.fffffep-1,
+				want:  0x1p-24,
+			},
+			{
+				input: 1e10,
+				want:  1024,
+			},
+			{
+				input: 0,
+				want:  math.SmallestNonzeroFloat32,
+			},
+			{
+				input: negzero32(),
+				want:  math.SmallestNonzeroFloat32,
+			},
+			{
+				input: math.SmallestNonzeroFloat32,
+				want:  math.SmallestNonzeroFloat32,
+			},
+			{
+				input: math.MaxFloat32,
+				want:  0x1p104,
+			},
+			{
+				input: -math.MaxFloat32,
+				want:  0x1p104,
+			},
+			{
+				input: Inf32(-1),
+				want:  Inf32(1),
+			},
+			{
+				input: NaN32(),
+				want:  NaN32(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Ulp(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input float64
+			want  float64
+		}{
+			{
+				input: 1,
+				want:  0x1p-52,
+			},
+			{
+				input: -1,
+				want:  0x1p-52,
+			},
+			{
+				input: 0x1.fffffffffffffp-1,
+				want:  0x1p-53,
+			},
+			{
+				input: 1e10,
+				want:  0x1p-19,
+			},
+			{
+				input: 0,
+				want:  math.SmallestNonzeroFloat64,
+			},
+			{
+				input: negzero64(),
+				want:  math.SmallestNonzeroFloat64,
+			},
+			{
+				input: math.MaxFloat64,
+				want:  0x1p971,
+			},
+			{
+				input: math.Inf(-1),
+				want:  math.Inf(1),
+			},
+			{
+				input: math.NaN(),
+				want:  math.NaN(),
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := Ulp(test.input)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestUlpDistance(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			want  uint64
+		}{
+			{
+				input: [2]float32{1, 1},
+				want:  0,
+			},
+			{
+				input: [2]float32{1, 0x1.000002p0},
+				want:  1,
+			},
+			{
+				input: [2]float32{0x1.000002p0, 1},
+				want:  1,
+			},
+			{
+				input: [2]float32{1, 0x1.fffffep-1},
+				want:  1,
+			},
+			{
+				input: [2]float32{1, 2},
+				want:  1 << 23,
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				want:  0,
+			},
+			{
+				input: [2]float32{math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32},
+				want:  2,
+			},
+			{
+				input: [2]float32{-1, 1},
+				want:  2 * 0x3F800000,
+			},
+			{
+				input: [2]float32{math.MaxFloat32, Inf32(1)},
+				want:  1,
+			},
+			{
+				input: [2]float32{-math.MaxFloat32, math.MaxFloat32},
+				want:  2 * 0x7F7FFFFF,
+			},
+			{
+				input: [2]float32{Inf32(-1), Inf32(1)},
+				want:  2 * 0x7F800000,
+			},
+			{
+				input: [2]float32{NaN32(), 1},
+				want:  math.MaxUint64,
+			},
+			{
+				input: [2]float32{1, NaN32()},
+				want:  math.MaxUint64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UlpDistance(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			want  uint64
+		}{
+			{
+				input: [2]float64{1, 1},
+				want:  0,
+			},
+			{
+				input: [2]float64{1, math.Nextafter(1, 2)},
+				want:  1,
+			},
+			{
+				input: [2]float64{1, 2},
+				want:  1 << 52,
+			},
+			{
+				input: [2]float64{negzero64(), 0},
+				want:  0,
+			},
+			{
+				input: [2]float64{-1, 1},
+				want:  2 * 0x3FF0000000000000,
+			},
+			{
+				input: [2]float64{math.MaxFloat64, math.Inf(1)},
+				want:  1,
+			},
+			{
+				input: [2]float64{math.Inf(-1), math.Inf(1)},
+				want:  2 * 0x7FF0000000000000,
+			},
+			{
+				input: [2]float64{math.NaN(), math.NaN()},
+				want:  math.MaxUint64,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input), func(t *testing.T) {
+				got := UlpDistance(test.input[0], test.input[1])
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}
+
+func TestAlmostEqual(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		tests := []struct {
+			input [2]float32
+			tol   Tolerance
+			want  bool
+		}{
+			{
+				input: [2]float32{1, 1},
+				tol:   Tolerance{},
+				want:  true,
+			},
+			{
+				input: [2]float32{1, 0x1.000002p0},
+				tol:   Tolerance{},
+				want:  false,
+			},
+			{
+				input: [2]float32{1, 0x1.000002p0},
+				tol:   Tolerance{ULPs: 1},
+				want:  true,
+			},
+			{
+				input: [2]float32{1, 0x1.000004p0},
+				tol:   Tolerance{ULPs: 1},
+				want:  false,
+			},
+			{
+				input: [2]float32{100, 100.001},
+				tol:   Tolerance{Rel: 1e-6},
+				want:  false,
+			},
+			{
+				input: [2]float32{100, 100.001},
+				tol:   Tolerance{Rel: 1e-5},
+				want:  true,
+			},
+			{
+				input: [2]float32{1e-10, -1e-10},
+				tol:   Tolerance{Rel: 0.5},
+				want:  false,
+			},
+			{
+				input: [2]float32{1e-10, -1e-10},
+				tol:   Tolerance{Abs: 1e-9},
+				want:  true,
+			},
+			{
+				input: [2]float32{0, negzero32()},
+				tol:   Tolerance{},
+				want:  true,
+			},
+			{
+				input: [2]float32{math.MaxFloat32, -math.MaxFloat32},
+				tol:   Tolerance{Rel: 2},
+				want:  true,
+			},
+			{
+				input: [2]float32{Inf32(1), Inf32(1)},
+				tol:   Tolerance{},
+				want:  true,
+			},
+			{
+				input: [2]float32{Inf32(1), Inf32(-1)},
+				tol:   Tolerance{Rel: 10, Abs: 10, ULPs: math.MaxUint64},
+				want:  false,
+			},
+			{
+				input: [2]float32{math.MaxFloat32, Inf32(1)},
+				tol:   Tolerance{ULPs: 1},
+				want:  false,
+			},
+			{
+				input: [2]float32{NaN32(), NaN32()},
+				tol:   Tolerance{ULPs: math.MaxUint64},
+				want:  false,
+			},
+			{
+				input: [2]float32{1, NaN32()},
+				tol:   Tolerance{Abs: 10},
+				want:  false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input, test.tol), func(t *testing.T) {
+				got := AlmostEqual(test.input[0], test.input[1], test.tol)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			input [2]float64
+			tol   Tolerance
+			want  bool
+		}{
+			{
+				input: [2]float64{1, 1},
+				tol:   Tolerance{},
+				want:  true,
+			},
+			{
+				input: [2]float64{0.30000000000000004, 0.3},
+				tol:   Tolerance{},
+				want:  false,
+			},
+			{
+				input: [2]float64{0.30000000000000004, 0.3},
+				tol:   Tolerance{ULPs: 1},
+				want:  true,
+			},
+			{
+				input: [2]float64{0.30000000000000004, 0.3},
+				tol:   Tolerance{Rel: 1e-15},
+				want:  true,
+			},
+			{
+				input: [2]float64{1e-300, 0},
+				tol:   Tolerance{Rel: 1e-9},
+				want:  false,
+			},
+			{
+				input: [2]float64{1e-300, 0},
+				tol:   Tolerance{Abs: 1e-299},
+				want:  true,
+			},
+			{
+				input: [2]float64{negzero64(), 0},
+				tol:   Tolerance{},
+				want:  true,
+			},
+			{
+				input: [2]float64{math.Inf(-1), math.Inf(-1)},
+				tol:   Tolerance{},
+				want:  true,
+			},
+			{
+				input: [2]float64{math.Inf(1), math.MaxFloat64},
+				tol:   Tolerance{Rel: 1},
+				want:  false,
+			},
+			{
+				input: [2]float64{math.NaN(), 1},
+				tol:   Tolerance{ULPs: math.MaxUint64},
+				want:  false,
+			},
+		}
+		for _, test := range tests {
+			t.Run(fmt.Sprint(test.input, test.tol), func(t *testing.T) {
+				got := AlmostEqual(test.input[0], test.input[1], test.tol)
+				assertEqual(t, test.want, got)
+			})
+		}
+	})
+}